@@ -0,0 +1,276 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+	"github.com/tmc/mongologtools/parser"
+)
+
+// operators matching a single value, which place a field in the equality
+// part of an index; all other operators make it a range predicate
+var equalityOperators = map[string]bool{"$eq": true, "$in": true}
+
+// IndexCandidate is a suggested index and the operations it would serve
+type IndexCandidate struct {
+	Namespace  string     `json:"ns"`
+	KeyPattern KeyPattern `json:"-"`
+	Index      string     `json:"index"`
+	Count      int        `json:"count"`
+	Millis     int64      `json:"total_ms"`
+	Shapes     []string   `json:"shapes"`
+	// CoveredBy is an index seen in plan summaries that already serves the candidate
+	CoveredBy string `json:"covered_by,omitempty"`
+
+	equality, sorts int
+	shapes          map[string]bool
+}
+
+// CandidateIndex returns the index following the equality, sort, range rule
+// for a query filter and sort: fields compared for equality, then the sort
+// fields, then fields with range predicates. Equality and range fields are
+// ordered by name. As parsed documents do not keep their field order, the
+// order of a sort on several fields is unknown and the index leaves it out.
+func CandidateIndex(filter, sort map[string]interface{}) KeyPattern {
+	index, _, _ := candidateIndex(filter, sort)
+	return index
+}
+
+func candidateIndex(filter, sortSpec map[string]interface{}) (index KeyPattern, equality, sorts int) {
+	eq, rng := map[string]bool{}, map[string]bool{}
+	classifyPredicates(filter, eq, rng)
+	seen := map[string]bool{}
+	for _, field := range sortedKeys(eq) {
+		index = append(index, KeyField{Field: field, Direction: int64(1)})
+		seen[field] = true
+	}
+	var sortFields []KeyField
+	for _, field := range sortedKeys(sortSpec) {
+		if dir := direction(sortSpec[field]); dir != 0 {
+			sortFields = append(sortFields, KeyField{Field: field, Direction: int64(dir)})
+		}
+	}
+	if len(sortFields) == 1 && !seen[sortFields[0].Field] {
+		index = append(index, sortFields[0])
+		seen[sortFields[0].Field] = true
+		sorts++
+	}
+	for _, field := range sortedKeys(rng) {
+		if !seen[field] {
+			index = append(index, KeyField{Field: field, Direction: int64(1)})
+			seen[field] = true
+		}
+	}
+	return index, len(eq), sorts
+}
+
+func classifyPredicates(filter map[string]interface{}, eq, rng map[string]bool) {
+	for field, v := range filter {
+		if field == "$and" {
+			clauses, _ := v.([]interface{})
+			for _, clause := range clauses {
+				if clause, ok := clause.(map[string]interface{}); ok {
+					classifyPredicates(clause, eq, rng)
+				}
+			}
+			continue
+		}
+		// $or, $where, $text and $expr clauses cannot be served by a single compound index
+		if strings.HasPrefix(field, "$") {
+			continue
+		}
+		switch v := v.(type) {
+		case mongo_json.RegExp:
+			rng[field] = true
+		case map[string]interface{}:
+			isEquality, operators := true, false
+			for op := range v {
+				if strings.HasPrefix(op, "$") {
+					operators = true
+					if !equalityOperators[op] {
+						isEquality = false
+					}
+				}
+			}
+			if operators && !isEquality {
+				rng[field] = true
+			} else {
+				eq[field] = true
+			}
+		default:
+			eq[field] = true
+		}
+	}
+	for field := range eq {
+		delete(rng, field)
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]interface{}:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// direction returns the sign of a key pattern or sort direction, or 0 for
+// special index types such as text.
+func direction(v interface{}) int {
+	var f float64
+	switch v := v.(type) {
+	case int64:
+		f = float64(v)
+	case float64:
+		f = v
+	case parser.Double:
+		f = float64(v)
+	case mongo_json.NumberLong:
+		f = float64(v)
+	case mongo_json.NumberInt:
+		f = float64(v)
+	}
+	switch {
+	case f > 0:
+		return 1
+	case f < 0:
+		return -1
+	}
+	return 0
+}
+
+// coveredBy reports whether index serves the candidate: its leading fields
+// are the candidate's equality fields in any order, followed by the sort
+// fields in order with all directions matching or all reversed, then the
+// range fields.
+func (c *IndexCandidate) coveredBy(index KeyPattern) bool {
+	if len(index) < len(c.KeyPattern) {
+		return false
+	}
+	eq := map[string]bool{}
+	for _, f := range c.KeyPattern[:c.equality] {
+		eq[f.Field] = true
+	}
+	for _, f := range index[:c.equality] {
+		if !eq[f.Field] {
+			return false
+		}
+	}
+	reversed := 0
+	for i := c.equality; i < len(c.KeyPattern); i++ {
+		if index[i].Field != c.KeyPattern[i].Field {
+			return false
+		}
+		if i < c.equality+c.sorts {
+			same := direction(index[i].Direction) == direction(c.KeyPattern[i].Direction)
+			switch {
+			case reversed == 0 && same:
+				reversed = -1
+			case reversed == 0:
+				reversed = 1
+			case (reversed == 1) == same:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Advisor collects index candidates from the slow operations of a log and
+// the indexes seen in plan summaries
+type Advisor struct {
+	// SlowMS is the duration from which operations are considered
+	SlowMS int64
+
+	candidates map[string]map[string]*IndexCandidate
+	indexes    map[string]map[string]KeyPattern
+}
+
+func NewAdvisor(slowMS int64) *Advisor {
+	return &Advisor{
+		SlowMS:     slowMS,
+		candidates: map[string]map[string]*IndexCandidate{},
+		indexes:    map[string]map[string]KeyPattern{},
+	}
+}
+
+// Add accounts for a parsed log line.
+func (a *Advisor) Add(record map[string]interface{}) {
+	ns := Namespace(record)
+	for _, stage := range PlanSummary(record) {
+		if len(stage.KeyPattern) == 0 {
+			continue
+		}
+		if a.indexes[ns] == nil {
+			a.indexes[ns] = map[string]KeyPattern{}
+		}
+		a.indexes[ns][stage.KeyPattern.String()] = stage.KeyPattern
+	}
+
+	millis, ok := Duration(record)
+	if !ok || millis < a.SlowMS {
+		return
+	}
+	filter, _ := Filter(record)
+	sortSpec, _ := Sort(record)
+	index, equality, sorts := candidateIndex(filter, sortSpec)
+	if len(index) == 0 {
+		return
+	}
+	key := index.String()
+	if a.candidates[ns] == nil {
+		a.candidates[ns] = map[string]*IndexCandidate{}
+	}
+	c, ok := a.candidates[ns][key]
+	if !ok {
+		c = &IndexCandidate{Namespace: ns, KeyPattern: index, Index: key, equality: equality, sorts: sorts, shapes: map[string]bool{}}
+		a.candidates[ns][key] = c
+	}
+	c.Count++
+	c.Millis += millis
+	c.shapes[Shape(filter)] = true
+}
+
+// Recommendations returns the candidates by time spent in the operations
+// they would serve, flagging those served by an existing index.
+func (a *Advisor) Recommendations() []*IndexCandidate {
+	var candidates []*IndexCandidate
+	for ns, byIndex := range a.candidates {
+		// every collection has an _id index
+		indexes := []KeyPattern{{{Field: "_id", Direction: int64(1)}}}
+		for _, index := range a.indexes[ns] {
+			indexes = append(indexes, index)
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i].String() < indexes[j].String() })
+		for _, c := range byIndex {
+			c.CoveredBy = ""
+			for _, index := range indexes {
+				if c.coveredBy(index) {
+					c.CoveredBy = index.String()
+					break
+				}
+			}
+			c.Shapes = sortedKeys(c.shapes)
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Millis != candidates[j].Millis {
+			return candidates[i].Millis > candidates[j].Millis
+		}
+		if candidates[i].Namespace != candidates[j].Namespace {
+			return candidates[i].Namespace < candidates[j].Namespace
+		}
+		return candidates[i].Index < candidates[j].Index
+	})
+	return candidates
+}
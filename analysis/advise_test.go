@@ -0,0 +1,72 @@
+package analysis_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+func TestCandidateIndex(t *testing.T) {
+	cases := []struct{ filter, sort, expected string }{
+		{`{ status: "A", age: { $gt: 21 }, name: /^b/ }`, `{ created: -1 }`, `{ status: 1, created: -1, age: 1, name: 1 }`},
+		{`{ status: { $in: [ "A", "B" ] }, tags: { $size: 2 } }`, `{}`, `{ status: 1, tags: 1 }`},
+		{`{ $and: [ { a: 1 }, { b: { $lt: 2 } } ], $or: [ { c: 1 }, { d: 1 } ] }`, `{ b: 1 }`, `{ a: 1, b: 1 }`},
+		{`{ a: { $gte: 1, $lte: 5 } }`, `{ a: 1, score: { $meta: "textScore" } }`, `{ a: 1 }`},
+		// the order of multi-field sorts isn't known
+		{`{ status: "A", age: { $gt: 21 } }`, `{ name: 1, created: -1 }`, `{ status: 1, age: 1 }`},
+	}
+	for i, testcase := range cases {
+		filter, err := parser.ConvertLogToExtended([]byte(testcase.filter))
+		if err != nil {
+			t.Fatalf("case %d: error parsing filter: %v", i, err)
+		}
+		sort, err := parser.ConvertLogToExtended([]byte(testcase.sort))
+		if err != nil {
+			t.Fatalf("case %d: error parsing sort: %v", i, err)
+		}
+		if index := analysis.CandidateIndex(filter, sort).String(); index != testcase.expected {
+			t.Errorf("case %d: expected %s, got %s", i, testcase.expected, index)
+		}
+	}
+}
+
+const adviseLog = `2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { $query: { status: "A", age: { $gt: 21 } }, $orderby: { created: -1 } } planSummary: COLLSCAN ntoreturn:0 nscanned:1000 nreturned:2 reslen:120 250ms
+2015-02-23T03:20:20.670+0000 I QUERY    [conn12] query test.users query: { $query: { status: "B", age: { $gt: 30 } }, $orderby: { created: -1 } } planSummary: COLLSCAN ntoreturn:0 nscanned:1000 nreturned:2 reslen:120 150ms
+2015-02-23T03:20:21.670+0000 I QUERY    [conn12] query test.users query: { $query: { name: "bob" }, $orderby: { created: 1 } } planSummary: IXSCAN { name: 1, created: -1 } ntoreturn:0 nscanned:2 nreturned:2 reslen:120 120ms
+2015-02-23T03:20:22.670+0000 I QUERY    [conn12] query test.users query: { _id: 7 } planSummary: IDHACK ntoreturn:0 nscanned:2 nreturned:2 reslen:120 110ms
+2015-02-23T03:20:23.670+0000 I QUERY    [conn12] query test.users query: { email: "x" } planSummary: COLLSCAN ntoreturn:0 nscanned:2 nreturned:2 reslen:120 20ms`
+
+func TestAdvisor(t *testing.T) {
+	advisor := analysis.NewAdvisor(100)
+	for _, line := range strings.Split(adviseLog, "\n") {
+		record, err := parser.ParseLogLine(line)
+		if err != nil {
+			t.Fatalf("error parsing %q: %v", line, err)
+		}
+		advisor.Add(record)
+	}
+	candidates := advisor.Recommendations()
+	if len(candidates) != 3 {
+		t.Fatalf("expected 3 candidates, got %+v", candidates)
+	}
+	expected := []struct {
+		index, coveredBy string
+		count            int
+		millis           int64
+	}{
+		{"{ status: 1, created: -1, age: 1 }", "", 2, 400},
+		{"{ name: 1, created: 1 }", "{ name: 1, created: -1 }", 1, 120},
+		{"{ _id: 1 }", "{ _id: 1 }", 1, 110},
+	}
+	for i, e := range expected {
+		c := candidates[i]
+		if c.Index != e.index || c.CoveredBy != e.coveredBy || c.Count != e.count || c.Millis != e.millis {
+			t.Errorf("candidate %d: expected %+v, got %+v", i, e, c)
+		}
+	}
+	if shapes := candidates[0].Shapes; len(shapes) != 1 || shapes[0] != "{ age: { $gt: ? }, status: ? }" {
+		t.Errorf("unexpected shapes %v", shapes)
+	}
+}
@@ -0,0 +1,2 @@
+// Package analysis summarizes parsed MongoDB log lines
+package analysis
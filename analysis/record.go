@@ -0,0 +1,177 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Namespace returns the namespace an operation applies to, resolving
+// commands run against db.$cmd to the collection they name.
+func Namespace(record map[string]interface{}) string {
+	ns, _ := record["ns"].(string)
+	if !strings.HasSuffix(ns, ".$cmd") {
+		return ns
+	}
+	command, _ := record["command"].(map[string]interface{})
+	commandType, _ := record["command_type"].(string)
+	if coll, ok := command[commandType].(string); ok && coll != "" {
+		return strings.TrimSuffix(ns, "$cmd") + coll
+	}
+	return ns
+}
+
+// Duration returns the duration of an operation in milliseconds.
+func Duration(record map[string]interface{}) (int64, bool) {
+	switch v := record["duration_ms"].(type) {
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// Filter returns the query predicate of an operation, unwrapping the
+// $query/query wrappers of legacy queries and the filter of commands.
+func Filter(record map[string]interface{}) (map[string]interface{}, bool) {
+	if query, ok := record["query"].(map[string]interface{}); ok {
+		for _, key := range []string{"$query", "query", "filter"} {
+			if inner, ok := query[key].(map[string]interface{}); ok {
+				return inner, true
+			}
+		}
+		return query, true
+	}
+	command, ok := record["command"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	for _, key := range []string{"filter", "query", "q"} {
+		if filter, ok := command[key].(map[string]interface{}); ok {
+			return filter, true
+		}
+	}
+	if pipeline, ok := command["pipeline"].([]interface{}); ok && len(pipeline) > 0 {
+		if stage, ok := pipeline[0].(map[string]interface{}); ok {
+			if match, ok := stage["$match"].(map[string]interface{}); ok {
+				return match, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Sort returns the sort specification of an operation.
+func Sort(record map[string]interface{}) (map[string]interface{}, bool) {
+	if query, ok := record["query"].(map[string]interface{}); ok {
+		for _, key := range []string{"$orderby", "orderby", "sort"} {
+			if sort, ok := query[key].(map[string]interface{}); ok {
+				return sort, true
+			}
+		}
+	}
+	if sort, ok := record["orderby"].(map[string]interface{}); ok {
+		return sort, true
+	}
+	command, _ := record["command"].(map[string]interface{})
+	if sort, ok := command["sort"].(map[string]interface{}); ok {
+		return sort, true
+	}
+	if pipeline, ok := command["pipeline"].([]interface{}); ok {
+		for _, stage := range pipeline {
+			stage, _ := stage.(map[string]interface{})
+			if sort, ok := stage["$sort"].(map[string]interface{}); ok {
+				return sort, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Shape renders a document with its literal values replaced by ?, so that
+// queries differing only in values share a shape. Keys are sorted.
+func Shape(v interface{}) string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fields := make([]string, len(keys))
+		for i, k := range keys {
+			fields[i] = k + ": " + Shape(v[k])
+		}
+		return "{ " + strings.Join(fields, ", ") + " }"
+	case []interface{}:
+		var elems []string
+		seen := map[string]bool{}
+		for _, elem := range v {
+			s := Shape(elem)
+			// lists of values such as $in collapse to a single element
+			if !seen[s] || s != "?" {
+				elems = append(elems, s)
+			}
+			seen[s] = true
+		}
+		if len(elems) == 0 {
+			return "[]"
+		}
+		return "[ " + strings.Join(elems, ", ") + " ]"
+	}
+	return "?"
+}
+
+// KeyField is a field of an index key pattern
+type KeyField struct {
+	Field     string
+	Direction interface{}
+}
+
+// KeyPattern is an index key pattern
+type KeyPattern []KeyField
+
+// String renders the key pattern, as in { a: 1, b: -1 }.
+func (k KeyPattern) String() string {
+	fields := make([]string, len(k))
+	for i, f := range k {
+		fields[i] = fmt.Sprintf("%s: %v", f.Field, f.Direction)
+	}
+	return "{ " + strings.Join(fields, ", ") + " }"
+}
+
+// PlanStage is a stage of a plan summary such as IXSCAN { a: 1 } or COLLSCAN
+type PlanStage struct {
+	Stage      string
+	KeyPattern KeyPattern
+}
+
+// PlanSummary returns the stages of the plan summary of an operation.
+func PlanSummary(record map[string]interface{}) []PlanStage {
+	elems, _ := record["planSummary"].([]interface{})
+	var stages []PlanStage
+	for _, elem := range elems {
+		elem, _ := elem.(map[string]interface{})
+		for stage, v := range elem {
+			s := PlanStage{Stage: stage}
+			// key patterns are lists of single field documents to keep their order
+			fields, _ := v.([]interface{})
+			for _, field := range fields {
+				field, _ := field.(map[string]interface{})
+				for name, direction := range field {
+					s.KeyPattern = append(s.KeyPattern, KeyField{Field: name, Direction: direction})
+				}
+			}
+			stages = append(stages, s)
+		}
+	}
+	return stages
+}
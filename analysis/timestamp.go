@@ -0,0 +1,31 @@
+package analysis
+
+import "time"
+
+// timestamp layouts used by the mongod log line formats
+var timestampLayouts = []string{
+	"2006-01-02T15:04:05.000-07:00", // 4.4+ json
+	"2006-01-02T15:04:05.000-0700",  // 2.6+ iso8601-local
+	"2006-01-02T15:04:05.000Z",      // 2.6+ iso8601-utc
+	"2006-01-02T15:04:05.000",
+	"Mon Jan _2 15:04:05.000", // 2.4 ctime
+	"Mon Jan _2 15:04:05",
+}
+
+// ParseTimestamp parses the timestamp field of a parsed log line. ctime
+// timestamps carry no year, so they are placed in the current year.
+func ParseTimestamp(v interface{}) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			if t.Year() == 0 {
+				t = t.AddDate(time.Now().Year(), 0, 0)
+			}
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
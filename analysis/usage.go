@@ -0,0 +1,136 @@
+package analysis
+
+import "sort"
+
+// IndexUse counts the operations that used an index
+type IndexUse struct {
+	KeyPattern string `json:"key_pattern"`
+	Count      int    `json:"count"`
+	Millis     int64  `json:"total_ms"`
+}
+
+// ShapeStats accumulates the operations sharing a query shape
+type ShapeStats struct {
+	Shape  string `json:"shape"`
+	Count  int    `json:"count"`
+	Millis int64  `json:"total_ms"`
+}
+
+// NamespaceUsage summarizes the plans of the operations on a namespace
+type NamespaceUsage struct {
+	Namespace      string `json:"ns"`
+	Operations     int    `json:"operations"`
+	CollScans      int    `json:"collscans"`
+	CollScanMillis int64  `json:"collscan_ms"`
+
+	indexes        map[string]*IndexUse
+	collScanShapes map[string]*ShapeStats
+}
+
+// Indexes returns the indexes used, most used first.
+func (u *NamespaceUsage) Indexes() []*IndexUse {
+	indexes := make([]*IndexUse, 0, len(u.indexes))
+	for _, index := range u.indexes {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		if indexes[i].Count != indexes[j].Count {
+			return indexes[i].Count > indexes[j].Count
+		}
+		return indexes[i].KeyPattern < indexes[j].KeyPattern
+	})
+	return indexes
+}
+
+// WorstCollScans returns up to n query shapes that caused collection scans,
+// by total time spent.
+func (u *NamespaceUsage) WorstCollScans(n int) []*ShapeStats {
+	shapes := make([]*ShapeStats, 0, len(u.collScanShapes))
+	for _, shape := range u.collScanShapes {
+		shapes = append(shapes, shape)
+	}
+	sort.Slice(shapes, func(i, j int) bool {
+		if shapes[i].Millis != shapes[j].Millis {
+			return shapes[i].Millis > shapes[j].Millis
+		}
+		return shapes[i].Shape < shapes[j].Shape
+	})
+	if len(shapes) > n {
+		shapes = shapes[:n]
+	}
+	return shapes
+}
+
+// UsageReport collects index usage and collection scans from plan summaries
+type UsageReport struct {
+	namespaces map[string]*NamespaceUsage
+}
+
+func NewUsageReport() *UsageReport {
+	return &UsageReport{namespaces: map[string]*NamespaceUsage{}}
+}
+
+// Add accounts for a parsed log line. Lines without a plan summary are ignored.
+func (r *UsageReport) Add(record map[string]interface{}) {
+	stages := PlanSummary(record)
+	if len(stages) == 0 {
+		return
+	}
+	ns := Namespace(record)
+	u, ok := r.namespaces[ns]
+	if !ok {
+		u = &NamespaceUsage{Namespace: ns, indexes: map[string]*IndexUse{}, collScanShapes: map[string]*ShapeStats{}}
+		r.namespaces[ns] = u
+	}
+	millis, _ := Duration(record)
+	u.Operations++
+	collScan := false
+	for _, stage := range stages {
+		switch stage.Stage {
+		case "COLLSCAN":
+			collScan = true
+		case "IXSCAN", "COUNT_SCAN", "DISTINCT_SCAN":
+			key := stage.KeyPattern.String()
+			index, ok := u.indexes[key]
+			if !ok {
+				index = &IndexUse{KeyPattern: key}
+				u.indexes[key] = index
+			}
+			index.Count++
+			index.Millis += millis
+		}
+	}
+	if !collScan {
+		return
+	}
+	u.CollScans++
+	u.CollScanMillis += millis
+	filter, _ := Filter(record)
+	shape := Shape(filter)
+	if filter == nil {
+		shape = "{}"
+	}
+	s, ok := u.collScanShapes[shape]
+	if !ok {
+		s = &ShapeStats{Shape: shape}
+		u.collScanShapes[shape] = s
+	}
+	s.Count++
+	s.Millis += millis
+}
+
+// Namespaces returns the namespaces seen, those losing the most time to
+// collection scans first.
+func (r *UsageReport) Namespaces() []*NamespaceUsage {
+	namespaces := make([]*NamespaceUsage, 0, len(r.namespaces))
+	for _, u := range r.namespaces {
+		namespaces = append(namespaces, u)
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].CollScanMillis != namespaces[j].CollScanMillis {
+			return namespaces[i].CollScanMillis > namespaces[j].CollScanMillis
+		}
+		return namespaces[i].Namespace < namespaces[j].Namespace
+	})
+	return namespaces
+}
@@ -0,0 +1,49 @@
+package analysis_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+const usageLog = `2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { $query: { name: "bob", age: { $gt: 21 } }, $orderby: { age: -1 } } planSummary: IXSCAN { name: 1, age: -1 } ntoreturn:0 nscanned:10 nreturned:2 reslen:120 250ms
+2015-02-23T03:20:20.670+0000 I QUERY    [conn12] query test.users query: { name: "alice" } planSummary: IXSCAN { name: 1, age: -1 } ntoreturn:0 nscanned:10 nreturned:2 reslen:120 50ms
+2015-02-23T03:20:21.670+0000 I COMMAND  [conn12] command test.$cmd command: count { count: "users", query: { status: "A" } } planSummary: COLLSCAN keyUpdates:0 numYields:0 reslen:44 110ms
+2015-02-23T03:20:22.670+0000 I QUERY    [conn13] query test.users query: { status: { $in: [ "A", "B" ] } } planSummary: COLLSCAN ntoreturn:0 nscanned:1000 nreturned:2 reslen:120 300ms
+2015-02-23T03:20:23.670+0000 I COMMAND  [conn13] command test.$cmd command: count { count: "users", query: { status: "B" } } planSummary: COLLSCAN keyUpdates:0 numYields:0 reslen:44 120ms
+2015-02-23T03:20:24.670+0000 I QUERY    [conn13] query test.orders query: { _id: 1 } planSummary: IDHACK ntoreturn:1 nreturned:1 reslen:120 0ms
+2015-02-23T03:20:25.670+0000 I WRITE    [conn14] insert test.orders query: { _id: 2 } ninserted:1 keyUpdates:0 0ms`
+
+func TestUsageReport(t *testing.T) {
+	report := analysis.NewUsageReport()
+	for _, line := range strings.Split(usageLog, "\n") {
+		record, err := parser.ParseLogLine(line)
+		if err != nil {
+			t.Fatalf("error parsing %q: %v", line, err)
+		}
+		report.Add(record)
+	}
+
+	namespaces := report.Namespaces()
+	if len(namespaces) != 2 || namespaces[0].Namespace != "test.users" || namespaces[1].Namespace != "test.orders" {
+		t.Fatalf("unexpected namespaces %v", namespaces)
+	}
+	users := namespaces[0]
+	if users.Operations != 5 || users.CollScans != 3 || users.CollScanMillis != 530 {
+		t.Errorf("unexpected totals %+v", users)
+	}
+	indexes := users.Indexes()
+	if len(indexes) != 1 || indexes[0].KeyPattern != "{ name: 1, age: -1 }" || indexes[0].Count != 2 || indexes[0].Millis != 300 {
+		t.Errorf("unexpected index usage %+v", indexes)
+	}
+	shapes := users.WorstCollScans(1)
+	if len(shapes) != 1 || shapes[0].Shape != "{ status: { $in: [ ? ] } }" || shapes[0].Millis != 300 {
+		t.Errorf("unexpected worst shape %+v", shapes)
+	}
+	shapes = users.WorstCollScans(5)
+	if len(shapes) != 2 || shapes[1].Shape != "{ status: ? }" || shapes[1].Count != 2 || shapes[1].Millis != 230 {
+		t.Errorf("unexpected shapes %+v", shapes)
+	}
+}
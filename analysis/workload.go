@@ -0,0 +1,201 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+// Op is an operation extracted from a log line for replay. Operations are
+// written one json document per line; the command is a database command in
+// extended JSON to run against the database of the namespace, e.g.
+//
+//	{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn12","ns":"test.users","op":"find","command":{"find":"users","filter":{"name":"bob"}},"duration_ms":250}
+type Op struct {
+	// Timestamp is the timestamp as logged, Time its parsed form if known
+	Timestamp  string     `json:"ts"`
+	Time       *time.Time `json:"time,omitempty"`
+	Conn       string     `json:"conn"`
+	Namespace  string     `json:"ns"`
+	Op         string     `json:"op"`
+	Command    Doc        `json:"command"`
+	DurationMS int64      `json:"duration_ms"`
+}
+
+// Elem is a field of a Doc
+type Elem struct {
+	Key   string
+	Value interface{}
+}
+
+// Doc is a document that keeps its field order when encoded, as database
+// commands must name the command first
+type Doc []Elem
+
+func (d Doc) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, elem := range d {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(elem.Key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(elem.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// commands extracted from command log lines, by the operation they replay as
+var workloadCommands = map[string]string{
+	"find":      "find",
+	"aggregate": "aggregate",
+	"count":     "count",
+	"update":    "update",
+	"insert":    "insert",
+	"delete":    "delete",
+}
+
+// command fields tied to the original session or connection
+var sessionFields = map[string]bool{
+	"lsid":             true,
+	"txnNumber":        true,
+	"autocommit":       true,
+	"startTransaction": true,
+}
+
+// WorkloadOp returns the operation to replay for a parsed log line: queries,
+// updates, inserts and removes as well as find, aggregate, count, update,
+// insert and delete commands. Legacy updates are marked multi when more
+// than one document matched, as the flag itself is not logged. Inserts are
+// only extracted when the inserted documents were logged.
+func WorkloadOp(record map[string]interface{}) (*Op, bool) {
+	ns := Namespace(record)
+	idx := strings.IndexByte(ns, '.')
+	if idx < 0 || strings.HasSuffix(ns, ".$cmd") {
+		return nil, false
+	}
+	coll := ns[idx+1:]
+	op := &Op{Namespace: ns}
+	op.Timestamp, _ = record["timestamp"].(string)
+	if t, ok := ParseTimestamp(op.Timestamp); ok {
+		t = t.UTC()
+		op.Time = &t
+	}
+	op.Conn, _ = record["context"].(string)
+	op.DurationMS, _ = Duration(record)
+
+	filter, hasFilter := Filter(record)
+	switch opType, _ := record["op"].(string); opType {
+	case "query":
+		op.Op = "find"
+		op.Command = Doc{{"find", coll}}
+		if hasFilter {
+			op.Command = append(op.Command, Elem{"filter", filter})
+		}
+		if sort, ok := Sort(record); ok {
+			op.Command = append(op.Command, Elem{"sort", sort})
+		}
+		if n := count(record, "ntoskip"); n > 0 {
+			op.Command = append(op.Command, Elem{"skip", n})
+		}
+		// a negative ntoreturn, or 1, asks for a single batch and closes the
+		// cursor, other values only size the first batch
+		switch n := count(record, "ntoreturn"); {
+		case n < 0 || n == 1:
+			if n < 0 {
+				n = -n
+			}
+			op.Command = append(op.Command, Elem{"limit", n}, Elem{"singleBatch", true})
+		case n > 1:
+			op.Command = append(op.Command, Elem{"batchSize", n})
+		}
+	case "update":
+		update, ok := record["update"]
+		if !ok {
+			update, ok = record["updateobj"]
+		}
+		if !ok || !hasFilter {
+			return nil, false
+		}
+		stmt := Doc{{"q", filter}, {"u", update}}
+		if count(record, "nMatched") > 1 || count(record, "nModified") > 1 {
+			stmt = append(stmt, Elem{"multi", true})
+		}
+		if count(record, "upsert") > 0 || record["upsert"] == true {
+			stmt = append(stmt, Elem{"upsert", true})
+		}
+		op.Op = "update"
+		op.Command = Doc{{"update", coll}, {"updates", []interface{}{stmt}}}
+	case "remove":
+		if !hasFilter {
+			return nil, false
+		}
+		op.Op = "delete"
+		op.Command = Doc{{"delete", coll}, {"deletes", []interface{}{Doc{{"q", filter}, {"limit", 0}}}}}
+	case "insert":
+		doc, ok := record["query"].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		op.Op = "insert"
+		op.Command = Doc{{"insert", coll}, {"documents", []interface{}{doc}}}
+	case "command":
+		commandType, _ := record["command_type"].(string)
+		command, _ := record["command"].(map[string]interface{})
+		replayAs, ok := workloadCommands[commandType]
+		if !ok || command == nil {
+			return nil, false
+		}
+		if commandType == "insert" {
+			if docs, _ := command["documents"].([]interface{}); len(docs) == 0 {
+				return nil, false
+			}
+		}
+		op.Op = replayAs
+		op.Command = commandDoc(commandType, command)
+	default:
+		return nil, false
+	}
+	return op, true
+}
+
+// commandDoc orders a command document with the command first and drops
+// the fields tied to the original session and the $ metadata fields.
+func commandDoc(name string, command map[string]interface{}) Doc {
+	doc := Doc{{name, command[name]}}
+	keys := make([]string, 0, len(command))
+	for k := range command {
+		if k != name && !sessionFields[k] && !strings.HasPrefix(k, "$") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		doc = append(doc, Elem{k, command[k]})
+	}
+	return doc
+}
+
+// count returns an integer field of a parsed log line.
+func count(record map[string]interface{}, field string) int64 {
+	switch v := record[field].(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	case parser.Double:
+		return int64(v)
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package analysis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+func TestWorkloadOp(t *testing.T) {
+	cases := []struct{ line, expected string }{
+		{
+			`2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { $query: { name: "bob" }, $orderby: { age: -1 } } planSummary: COLLSCAN ntoreturn:-1 ntoskip:5 nscanned:10 nreturned:1 reslen:120 250ms`,
+			`{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn12","ns":"test.users","op":"find","command":{"find":"users","filter":{"name":"bob"},"sort":{"age":-1},"skip":5,"limit":1,"singleBatch":true},"duration_ms":250}`,
+		},
+		{
+			`2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { name: "bob" } planSummary: COLLSCAN ntoreturn:20 nscanned:10 nreturned:1 reslen:120 250ms`,
+			`{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn12","ns":"test.users","op":"find","command":{"find":"users","filter":{"name":"bob"},"batchSize":20},"duration_ms":250}`,
+		},
+		{
+			`2015-02-23T03:20:19.670+0000 I WRITE    [conn3] update shop.users query: { status: "A" } update: { $set: { seen: true } } nscanned:4 nMatched:4 nModified:4 keyUpdates:0 3ms`,
+			`{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn3","ns":"shop.users","op":"update","command":{"update":"users","updates":[{"q":{"status":"A"},"u":{"$set":{"seen":true}},"multi":true}]},"duration_ms":3}`,
+		},
+		{
+			`2015-02-23T03:20:19.670+0000 I WRITE    [conn3] remove shop.carts query: { expires: { $lt: new Date(1424661619670) } } ndeleted:12 keyUpdates:0 120ms`,
+			`{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn3","ns":"shop.carts","op":"delete","command":{"delete":"carts","deletes":[{"q":{"expires":{"$lt":{"$date":"2015-02-23T03:20:19.670Z"}}},"limit":0}]},"duration_ms":120}`,
+		},
+		{
+			`2015-02-23T03:20:19.670+0000 I COMMAND  [conn1] command shop.$cmd command: aggregate { aggregate: "orders", pipeline: [ { $match: { status: "A" } } ], $db: "shop", lsid: { id: 1 } } keyUpdates:0 numYields:0 reslen:44 110ms`,
+			`{"ts":"2015-02-23T03:20:19.670+0000","time":"2015-02-23T03:20:19.67Z","conn":"conn1","ns":"shop.orders","op":"aggregate","command":{"aggregate":"orders","pipeline":[{"$match":{"status":"A"}}]},"duration_ms":110}`,
+		},
+		{
+			`Mon Feb 23 03:20:19.670 [conn1] insert test.orders ninserted:1 keyUpdates:0 0ms`,
+			``,
+		},
+		{
+			`2015-02-23T03:20:19.670+0000 I COMMAND  [conn1] command admin.$cmd command: isMaster { isMaster: 1 } keyUpdates:0 numYields:0 reslen:44 0ms`,
+			``,
+		},
+	}
+	for i, testcase := range cases {
+		record, err := parser.ParseLogLine(testcase.line)
+		if err != nil {
+			t.Fatalf("case %d: error parsing: %v", i, err)
+		}
+		op, ok := analysis.WorkloadOp(record)
+		if !ok {
+			if testcase.expected != "" {
+				t.Errorf("case %d: expected an operation", i)
+			}
+			continue
+		}
+		buf, err := json.Marshal(op)
+		if err != nil {
+			t.Fatalf("case %d: error marshaling: %v", i, err)
+		}
+		if string(buf) != testcase.expected {
+			t.Errorf("case %d: expected '%s'\nbut got '%s'", i, testcase.expected, buf)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Command extract-mongo-workload extracts the queries, updates, inserts,
+// removes, aggregations and counts of a log as operations to replay against
+// another cluster, written one json document per line (see analysis.Op).
+//
+// Usage:
+//
+//	extract-mongo-workload [-o file] [file ...]
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+var flagOutput = flag.String("o", "-", "output file")
+
+func main() {
+	flag.Parse()
+	var w io.Writer = os.Stdout
+	if *flagOutput != "-" {
+		f, err := os.Create(*flagOutput)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error opening output:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	out := json.NewEncoder(bw)
+
+	inputs := flag.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	var extracted, skipped int
+	for _, input := range inputs {
+		err := read(input, func(record map[string]interface{}) error {
+			op, ok := analysis.WorkloadOp(record)
+			if !ok {
+				skipped++
+				return nil
+			}
+			extracted++
+			return out.Encode(op)
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error extracting workload:", err)
+			os.Exit(1)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, "error writing output:", err)
+		os.Exit(1)
+	}
+	log.Printf("%d operations extracted, %d lines skipped\n", extracted, skipped)
+}
+
+func read(path string, fn func(map[string]interface{}) error) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for s.Scan() {
+		record, err := parser.ParseLogLine(s.Text())
+		if err != nil {
+			continue
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
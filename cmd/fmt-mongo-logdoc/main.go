@@ -0,0 +1,78 @@
+// Command fmt-mongo-logdoc pretty-prints MongoDB log documents such as
+// { a: { $gt: 1 } }, read one per line.
+//
+// Usage:
+//
+//	fmt-mongo-logdoc [-indent str] [file ...]
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+var flagIndent = flag.String("indent", "  ", "indentation of nested values, empty to write each document on one line")
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	w := bufio.NewWriter(os.Stdout)
+	status := 0
+	for _, input := range inputs {
+		if err := format(w, input); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, "error writing output:", err)
+		status = 1
+	}
+	os.Exit(status)
+}
+
+// format writes the documents read from path, reporting the first line
+// that is not a document after formatting the remaining ones.
+func format(w io.Writer, path string) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	var firstErr error
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		doc, err := parser.ConvertLogToExtended([]byte(line))
+		if err == nil {
+			var buf []byte
+			if buf, err = parser.FormatLogDocIndent(doc, *flagIndent); err == nil {
+				fmt.Fprintf(w, "%s\n", buf)
+			}
+		}
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	return firstErr
+}
@@ -0,0 +1,105 @@
+// Command generate-mongo-loglines writes synthetic MongoDB log lines.
+//
+// Usage:
+//
+//	generate-mongo-loglines [-version 3.0] [-n 1000] [-mix query=5,update=1] [-ns test.users,shop.orders] [-rate lines/s]
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmc/mongologtools/generator"
+)
+
+var (
+	flagVersion  = flag.String("version", "3.0", "log format version: "+strings.Join(generator.Versions, ", "))
+	flagN        = flag.Int("n", 1000, "number of lines, 0 for no limit")
+	flagMix      = flag.String("mix", "", "operation weights, e.g. query=5,update=1,insert=1,remove=1,getmore=1,command=1")
+	flagNS       = flag.String("ns", "", "comma separated namespaces")
+	flagFields   = flag.String("fields", "", "comma separated document field names")
+	flagMinMS    = flag.Int("min-ms", 100, "minimum operation duration in ms")
+	flagMaxMS    = flag.Int("max-ms", 2000, "maximum operation duration in ms")
+	flagInterval = flag.Duration("interval", 100*time.Millisecond, "mean time between the timestamps of lines")
+	flagStart    = flag.String("start", "", "timestamp of the first line, in RFC 3339 format")
+	flagSeed     = flag.Int64("seed", 0, "random seed, 0 to seed from the clock")
+	flagRate     = flag.Float64("rate", 0, "lines written per second, 0 for as fast as possible")
+)
+
+func main() {
+	flag.Parse()
+	cfg := generator.Config{
+		Version:   *flagVersion,
+		MinMillis: *flagMinMS,
+		MaxMillis: *flagMaxMS,
+		Interval:  *flagInterval,
+		Seed:      *flagSeed,
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	if *flagNS != "" {
+		cfg.Namespaces = strings.Split(*flagNS, ",")
+	}
+	if *flagFields != "" {
+		cfg.Fields = strings.Split(*flagFields, ",")
+	}
+	if *flagStart != "" {
+		start, err := time.Parse(time.RFC3339, *flagStart)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error parsing start time:", err)
+			os.Exit(1)
+		}
+		cfg.Start = start
+	}
+	if *flagMix != "" {
+		cfg.Mix = map[string]int{}
+		for _, kv := range strings.Split(*flagMix, ",") {
+			parts := strings.SplitN(kv, "=", 2)
+			weight, err := strconv.Atoi(parts[len(parts)-1])
+			if len(parts) != 2 || err != nil {
+				fmt.Fprintf(os.Stderr, "error parsing mix: %q is not of the form op=weight\n", kv)
+				os.Exit(1)
+			}
+			cfg.Mix[parts[0]] = weight
+		}
+	}
+	g, err := generator.New(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error configuring generator:", err)
+		os.Exit(1)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	var tick <-chan time.Time
+	if *flagRate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / *flagRate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for i := 0; *flagN == 0 || i < *flagN; i++ {
+		if tick != nil {
+			<-tick
+		}
+		if _, err := fmt.Fprintln(w, g.Line()); err != nil {
+			fmt.Fprintln(os.Stderr, "error writing:", err)
+			os.Exit(1)
+		}
+		// paced output is delivered line by line
+		if tick != nil {
+			if err := w.Flush(); err != nil {
+				fmt.Fprintln(os.Stderr, "error writing:", err)
+				os.Exit(1)
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, "error writing:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// checkpoint records how far an input file has been ingested
+type checkpoint struct {
+	Path   string `json:"path"`
+	Device uint64 `json:"device"`
+	Inode  uint64 `json:"inode"`
+	Offset int64  `json:"offset"`
+	Line   int64  `json:"line,omitempty"`
+	// Timestamp is that of the last ingested line, reported when the
+	// unread lines of a rotated file are skipped
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// resumer is implemented by inputs that can continue from a checkpoint
+type resumer interface {
+	Resume(ctx context.Context, cp *checkpoint) (io.ReadCloser, error)
+}
+
+// resumeWriter is implemented by outputs that open differently for a run
+// resumed from a checkpoint, so as to keep the records of previous runs
+type resumeWriter interface {
+	ResumeWriter(ctx context.Context) (io.WriteCloser, error)
+}
+
+// openWriter opens output, keeping its previous records if the run resumes
+// from a checkpoint.
+func openWriter(ctx context.Context, output IO, resume bool) (io.WriteCloser, error) {
+	if resumable, ok := output.(resumeWriter); ok && resume {
+		return resumable.ResumeWriter(ctx)
+	}
+	return output.Writer(ctx)
+}
+
+// loadCheckpoint reads the checkpoint stored at path, returning an empty
+// checkpoint if there is none yet.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	cp := &checkpoint{}
+	buf, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, cp); err != nil {
+		return nil, fmt.Errorf("checkpoint %s: %v", path, err)
+	}
+	return cp, nil
+}
+
+// save atomically replaces the checkpoint stored at path.
+func (cp *checkpoint) save(path string) error {
+	buf, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(buf, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// openCheckpointed opens path and positions it at the checkpointed offset.
+// A file with a different identity (rotated) or smaller than the offset
+// (truncated) is read from the start. cp is updated to describe the opened
+// file.
+func openCheckpointed(path string, cp *checkpoint) (*os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	device, inode := fileIdentity(fi)
+	switch {
+	case cp.Path != path || cp.Offset == 0:
+		cp.Offset, cp.Line, cp.Timestamp = 0, 0, ""
+	case cp.Device != device || cp.Inode != inode:
+		log.Printf("%s was rotated, reading the new file from the start%s\n", path, rotatedTail(path, cp))
+		cp.Offset, cp.Line, cp.Timestamp = 0, 0, ""
+	case fi.Size() < cp.Offset:
+		log.Printf("%s was truncated to %d bytes, reading from the start\n", path, fi.Size())
+		cp.Offset, cp.Line, cp.Timestamp = 0, 0, ""
+	}
+	if _, err := f.Seek(cp.Offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	cp.Path, cp.Device, cp.Inode = path, device, inode
+	return f, nil
+}
+
+// rotatedTail describes the unread tail of the checkpointed file, found by
+// its identity next to path, which is skipped when it has been rotated.
+func rotatedTail(path string, cp *checkpoint) string {
+	after := ""
+	if cp.Timestamp != "" {
+		after = " after the line logged at " + cp.Timestamp
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		fi, err := entry.Info()
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		if device, inode := fileIdentity(fi); inode == 0 || device != cp.Device || inode != cp.Inode {
+			continue
+		}
+		if skipped := fi.Size() - cp.Offset; skipped > 0 {
+			return fmt.Sprintf(", skipping %d unread bytes of %s%s", skipped, entry.Name(), after)
+		}
+		return ""
+	}
+	return ", any unread lines of the previous file" + after + " are skipped"
+}
+
+// checkpointer keeps the checkpoint up to date with ingestion progress and
+// saves it at intervals, after flushing outputs so that no saved offset
+// covers records still held in a buffer.
+type checkpointer struct {
+	path     string
+	interval time.Duration
+	cp       *checkpoint
+	base     int64
+	lineBase int64
+	outputs  []io.Writer
+	saved    time.Time
+}
+
+func newCheckpointer(path string, interval time.Duration, cp *checkpoint, outputs ...io.Writer) *checkpointer {
+	return &checkpointer{path: path, interval: interval, cp: cp, base: cp.Offset, lineBase: cp.Line, outputs: outputs, saved: time.Now()}
+}
+
+// progress is called by ingest once a line has been handled.
+func (c *checkpointer) progress(stats ingestStats) error {
+	c.cp.Offset = c.base + stats.offset
+	c.cp.Line = c.lineBase + int64(stats.parsed+stats.failed)
+	if stats.timestamp != "" {
+		c.cp.Timestamp = stats.timestamp
+	}
+	if time.Since(c.saved) < c.interval {
+		return nil
+	}
+	for _, w := range c.outputs {
+		if f, ok := w.(interface {
+			Flush() error
+		}); ok {
+			if err := f.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return c.save()
+}
+
+func (c *checkpointer) save() error {
+	c.saved = time.Now()
+	if err := c.cp.save(c.path); err != nil {
+		return fmt.Errorf("saving checkpoint: %v", err)
+	}
+	return nil
+}
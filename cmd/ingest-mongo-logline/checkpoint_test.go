@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	dir := t.TempDir()
+	logPath, statePath := filepath.Join(dir, "mongod.log"), filepath.Join(dir, "state.json")
+	if err := os.WriteFile(logPath, []byte(ingestInput), 0600); err != nil {
+		t.Fatal(err)
+	}
+	input := &fileio{path: logPath}
+
+	run := func() (ingestStats, *checkpoint) {
+		cp, err := loadCheckpoint(statePath)
+		if err != nil {
+			t.Fatalf("error loading checkpoint: %v", err)
+		}
+		r, err := input.Resume(context.Background(), cp)
+		if err != nil {
+			t.Fatalf("error opening input: %v", err)
+		}
+		defer r.Close()
+		var records bytes.Buffer
+		c := newCheckpointer(statePath, time.Hour, cp, &records)
+		stats, err := ingest(context.Background(), r, json.NewEncoder(&records), ingestOptions{onError: onErrorSkip, progress: c.progress, holdPartial: true})
+		if err != nil {
+			t.Fatalf("error ingesting: %v", err)
+		}
+		if err := c.save(); err != nil {
+			t.Fatal(err)
+		}
+		return stats, cp
+	}
+
+	stats, cp := run()
+	if stats.parsed != 2 || cp.Offset != int64(len(ingestInput)) || cp.Timestamp == "" {
+		t.Fatalf("unexpected first run: %v, %+v", stats, cp)
+	}
+
+	// nothing new to read
+	if stats, _ = run(); stats.parsed+stats.failed != 0 {
+		t.Errorf("expected no lines on resume, got %v", stats)
+	}
+
+	// appended lines are picked up where the last run stopped
+	f, _ := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	io.WriteString(f, ingestInput)
+	f.Close()
+	if stats, cp = run(); stats.parsed != 2 || cp.Offset != 2*int64(len(ingestInput)) {
+		t.Errorf("expected the appended lines, got %v, %+v", stats, cp)
+	}
+
+	// a line still being written is left for the next run
+	line := "Mon Feb 23 03:22:19.670 [conn1] query test.users query: { name: \"alice\" } ntoreturn:0 nreturned:1 reslen:20 3ms\n"
+	f, _ = os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	io.WriteString(f, line[:40])
+	if stats, cp = run(); stats.parsed+stats.failed != 0 || cp.Offset != 2*int64(len(ingestInput)) {
+		t.Errorf("expected the partial line to be held back, got %v, %+v", stats, cp)
+	}
+	io.WriteString(f, line[40:])
+	f.Close()
+	if stats, cp = run(); stats.parsed != 1 || stats.failed != 0 || cp.Offset != 2*int64(len(ingestInput))+int64(len(line)) {
+		t.Errorf("expected the completed line, got %v, %+v", stats, cp)
+	}
+
+	// truncation restarts from the beginning
+	os.WriteFile(logPath, []byte(ingestInput[:100]+"\n"), 0600)
+	if stats, _ = run(); stats.parsed+stats.failed != 1 {
+		t.Errorf("expected the truncated file to be read from the start, got %v", stats)
+	}
+
+	// as does rotation to a new file, even when it is larger, with a
+	// warning about the unread lines of the previous file
+	f, _ = os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	io.WriteString(f, line)
+	f.Close()
+	os.Rename(logPath, logPath+".1")
+	os.WriteFile(logPath, []byte(ingestInput+ingestInput+ingestInput), 0600)
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+	if stats, _ = run(); stats.parsed != 6 {
+		t.Errorf("expected the rotated file to be read from the start, got %v", stats)
+	}
+	if runtime.GOOS != "windows" && !strings.Contains(logged.String(), fmt.Sprintf("skipping %d unread bytes of mongod.log.1 after the line logged at ", len(line))) {
+		t.Errorf("expected a warning about the skipped lines, got %q", logged.String())
+	}
+}
+
+func TestCheckpointResumeOutput(t *testing.T) {
+	dir := t.TempDir()
+	logPath, statePath, outPath := filepath.Join(dir, "mongod.log"), filepath.Join(dir, "state.json"), filepath.Join(dir, "out.jsonl")
+	if err := os.WriteFile(logPath, []byte(ingestInput), 0600); err != nil {
+		t.Fatal(err)
+	}
+	input := &fileio{path: logPath}
+	output, _ := GetIO("file://" + outPath)
+
+	run := func() {
+		cp, err := loadCheckpoint(statePath)
+		if err != nil {
+			t.Fatalf("error loading checkpoint: %v", err)
+		}
+		r, err := input.Resume(context.Background(), cp)
+		if err != nil {
+			t.Fatalf("error opening input: %v", err)
+		}
+		defer r.Close()
+		w, err := output.(resumeWriter).ResumeWriter(context.Background())
+		if err != nil {
+			t.Fatalf("error opening output: %v", err)
+		}
+		c := newCheckpointer(statePath, time.Hour, cp, w)
+		if _, err := ingest(context.Background(), r, json.NewEncoder(w), ingestOptions{onError: onErrorSkip, progress: c.progress}); err != nil {
+			t.Fatalf("error ingesting: %v", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the records of the first run survive the restart
+	run()
+	f, _ := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	io.WriteString(f, ingestInput)
+	f.Close()
+	run()
+	buf, _ := os.ReadFile(outPath)
+	if n := bytes.Count(buf, []byte("\n")); n != 4 {
+		t.Errorf("expected the 4 records of both runs, got %d: %s", n, buf)
+	}
+
+	overwrite, _ := GetIO("file://" + outPath + "?mode=overwrite")
+	if _, err := overwrite.(resumeWriter).ResumeWriter(context.Background()); err == nil {
+		t.Error("expected an error for overwriting a resumed output")
+	}
+}
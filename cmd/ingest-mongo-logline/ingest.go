@@ -2,22 +2,156 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
+	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"log"
 
 	"github.com/tmc/mongologtools/parser"
 )
 
-func ingest(r io.Reader, w io.Writer) error {
+// maxLineSize bounds the length of a single input line
+const maxLineSize = 16 * 1024 * 1024
+
+// policies for lines that fail to parse
+const (
+	onErrorSkip       = "skip"
+	onErrorRaw        = "raw"
+	onErrorDeadLetter = "dead-letter"
+)
+
+type ingestOptions struct {
+	// onError selects what happens to lines that fail to parse
+	onError string
+	// deadLetter receives the raw text of failed lines for the dead-letter policy
+	deadLetter io.Writer
+	// strict stops ingestion at the first line that fails to parse
+	strict bool
+	// progress, if set, is called after each line has been handled
+	progress func(ingestStats) error
+	// version, if set, pins the log format generation; lines in other
+	// formats fail to parse
+	version string
+	// source selects the source metadata attached to each record
+	source sourceOptions
+	// holdPartial leaves an unterminated last line, such as one still being
+	// written to a live log, unread for the next run of a checkpointed input
+	holdPartial bool
+}
+
+func (o ingestOptions) validate() error {
+	if o.version != "" && !parser.ValidVersion(o.version) {
+		return fmt.Errorf("unknown log format version %q", o.version)
+	}
+	switch o.onError {
+	case onErrorSkip, onErrorRaw:
+	case onErrorDeadLetter:
+		if o.deadLetter == nil {
+			return fmt.Errorf("the %s policy requires a dead-letter output", onErrorDeadLetter)
+		}
+	default:
+		return fmt.Errorf("unknown parse error policy %q", o.onError)
+	}
+	return nil
+}
+
+type ingestStats struct {
+	parsed int
+	failed int
+	// offset counts the bytes of the lines handled so far
+	offset int64
+	// start is the offset of the current line
+	start int64
+	// timestamp is the timestamp of the last parsed record
+	timestamp string
+	// versions infers the log format generation of the input
+	versions parser.VersionDetector
+}
+
+func (s ingestStats) String() string {
+	msg := fmt.Sprintf("%d lines parsed, %d failed", s.parsed, s.failed)
+	if v := s.versions.Version(); v != "" {
+		msg += ", log format " + v
+	}
+	return msg
+}
+
+// ingest parses the lines read from r and encodes the resulting records to
+// out. Once ctx is done the reader is expected to stop; the lines read so far
+// are still processed and the resulting read error is not reported.
+func ingest(ctx context.Context, r io.Reader, out encoder, opts ingestOptions) (ingestStats, error) {
+	var stats ingestStats
+	if err := opts.validate(); err != nil {
+		return stats, err
+	}
 	s := bufio.NewScanner(r)
-	out := json.NewEncoder(w)
+	s.Buffer(nil, maxLineSize)
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := bufio.ScanLines(data, atEOF)
+		if atEOF && opts.holdPartial && bytes.IndexByte(data[:advance], '\n') < 0 {
+			return 0, nil, nil
+		}
+		if token != nil {
+			stats.start = stats.offset
+		}
+		stats.offset += int64(advance)
+		return advance, token, err
+	})
 	for s.Scan() {
-		r, err := parser.ParseLogLine(s.Text())
-		if err != nil {
-			log.Printf("line parsing err on `%s..`\n", string(s.Bytes()[:min(len(s.Text()), 30)]))
+		if err := handleLine(s.Bytes(), out, opts, &stats); err != nil {
+			return stats, err
+		}
+		if opts.progress != nil {
+			if err := opts.progress(stats); err != nil {
+				return stats, err
+			}
 		}
-		out.Encode(r)
+	}
+	if err := s.Err(); err != nil && ctx.Err() == nil {
+		return stats, fmt.Errorf("reading input: %v", err)
+	}
+	return stats, nil
+}
+
+// handleLine parses a single line and writes the record or applies the
+// parse error policy.
+func handleLine(line []byte, out encoder, opts ingestOptions, stats *ingestStats) error {
+	r, err := parser.ParseLogLineWithOptions(string(line), parser.ParseOptions{Version: opts.version, KeepRaw: opts.source.raw})
+	if err == nil {
+		stats.parsed++
+		opts.source.attach(r, stats)
+		stats.versions.Observe(r)
+		if ts, ok := r["timestamp"].(string); ok {
+			stats.timestamp = ts
+		}
+		if err := out.Encode(r); err != nil {
+			return fmt.Errorf("writing record: %v", err)
+		}
+		return nil
+	}
+
+	stats.failed++
+	reason := "parse error"
+	if mismatch, ok := err.(*parser.ErrVersionMismatch); ok {
+		reason = mismatch.Error()
+	}
+	if opts.strict {
+		return fmt.Errorf("line %d: %s on `%s..`", stats.parsed+stats.failed, reason, line[:min(len(line), 30)])
+	}
+	log.Printf("line parsing err on `%s..`: %s\n", line[:min(len(line), 30)], reason)
+	switch opts.onError {
+	case onErrorRaw:
+		r := map[string]interface{}{parser.RawField: string(line), "parse_error": true}
+		opts.source.attach(r, stats)
+		err = out.Encode(r)
+	case onErrorDeadLetter:
+		_, err = fmt.Fprintln(opts.deadLetter, string(line))
+	default:
+		err = nil
+	}
+	if err != nil {
+		return fmt.Errorf("writing failed line: %v", err)
 	}
 	return nil
 }
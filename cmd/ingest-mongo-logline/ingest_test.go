@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+const ingestInput = `Mon Feb 23 03:20:19.670 [TTLMonitor] query local.system.indexes query: { expireAfterSeconds: { $exists: true } } ntoreturn:0 ntoskip:0 nscanned:0 keyUpdates:0 locks(micros) r:86 nreturned:0 reslen:20 0ms
+not a log line
+Mon Feb 23 03:21:19.670 [conn1] query test.users query: { name: "bob" } ntoreturn:0 nreturned:1 reslen:20 3ms
+`
+
+func TestIngestPolicies(t *testing.T) {
+	cases := []struct {
+		opts                ingestOptions
+		records, deadLetter string
+		parsed, failed      int
+		expectError         bool
+	}{
+		{ingestOptions{onError: onErrorSkip}, `"op":"query"`, "", 2, 1, false},
+		{ingestOptions{onError: onErrorRaw}, `{"parse_error":true,"raw":"not a log line"}`, "", 2, 1, false},
+		{ingestOptions{onError: onErrorDeadLetter}, `"op":"query"`, "not a log line\n", 2, 1, false},
+		{ingestOptions{onError: onErrorSkip, strict: true}, `"op":"query"`, "", 1, 1, true},
+		{ingestOptions{onError: onErrorDeadLetter, deadLetter: nil}, "", "", 0, 0, true},
+		{ingestOptions{onError: "ignore"}, "", "", 0, 0, true},
+		{ingestOptions{onError: onErrorSkip, version: "2.4"}, `"log_version":"2.4"`, "", 2, 1, false},
+		{ingestOptions{onError: onErrorDeadLetter, version: "2.6"}, "", ingestInput, 0, 3, false},
+		{ingestOptions{onError: onErrorSkip, version: "2.5"}, "", "", 0, 0, true},
+	}
+	for i, testcase := range cases {
+		var records, deadLetter bytes.Buffer
+		if testcase.opts.onError == onErrorDeadLetter && !testcase.expectError {
+			testcase.opts.deadLetter = &deadLetter
+		}
+		stats, err := ingest(context.Background(), strings.NewReader(ingestInput), json.NewEncoder(&records), testcase.opts)
+		if (err != nil) != testcase.expectError {
+			t.Errorf("case %d: unexpected error value: %v", i, err)
+		}
+		if stats.parsed != testcase.parsed || stats.failed != testcase.failed {
+			t.Errorf("case %d: expected %d parsed and %d failed, got %v", i, testcase.parsed, testcase.failed, stats)
+		}
+		if strings.Contains(records.String(), "null") {
+			t.Errorf("case %d: null record written", i)
+		}
+		if !strings.Contains(records.String(), testcase.records) {
+			t.Errorf("case %d: expected output containing %s, got %s", i, testcase.records, records.String())
+		}
+		if deadLetter.String() != testcase.deadLetter {
+			t.Errorf("case %d: expected dead letters %q, got %q", i, testcase.deadLetter, deadLetter.String())
+		}
+	}
+}
+
+type failingEncoder struct{}
+
+func (failingEncoder) Encode(v interface{}) error { return errors.New("disk full") }
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) { return 0, errors.New("connection reset") }
+
+func TestIngestErrors(t *testing.T) {
+	if _, err := ingest(context.Background(), strings.NewReader(ingestInput), failingEncoder{}, ingestOptions{onError: onErrorSkip}); err == nil {
+		t.Error("expected write errors to be returned")
+	}
+	if _, err := ingest(context.Background(), failingReader{}, json.NewEncoder(&bytes.Buffer{}), ingestOptions{onError: onErrorSkip}); err == nil {
+		t.Error("expected read errors to be returned")
+	}
+}
+
+func TestIngestShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pr, pw := io.Pipe()
+	go func() {
+		io.WriteString(pw, ingestInput)
+		// the input stays open, only the cancellation ends ingestion
+		cancel()
+	}()
+	var records bytes.Buffer
+	stats, err := ingest(ctx, closeOnDone(ctx, pr), json.NewEncoder(&records), ingestOptions{onError: onErrorSkip})
+	if err != nil {
+		t.Fatalf("expected a clean stop, got %v", err)
+	}
+	if stats.parsed != 2 || stats.failed != 1 {
+		t.Errorf("expected the lines read before shutdown to be processed, got %v", stats)
+	}
+}
+
+// blockingReader is like stdin on an idle terminal: Read blocks and Close
+// doesn't interrupt it.
+type blockingReader struct {
+	data    string
+	release chan struct{}
+}
+
+func (r *blockingReader) Read(p []byte) (int, error) {
+	if r.data != "" {
+		n := copy(p, r.data)
+		r.data = r.data[n:]
+		return n, nil
+	}
+	<-r.release
+	return 0, io.EOF
+}
+
+func (r *blockingReader) Close() error { return nil }
+
+func TestIngestShutdownBlockedRead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &blockingReader{data: ingestInput, release: make(chan struct{})}
+	defer close(r.release)
+	done := make(chan ingestStats)
+	go func() {
+		stats, err := ingest(ctx, closeOnDone(ctx, r), json.NewEncoder(io.Discard), ingestOptions{onError: onErrorSkip})
+		if err != nil {
+			t.Errorf("expected a clean stop, got %v", err)
+		}
+		done <- stats
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case stats := <-done:
+		if stats.parsed != 2 || stats.failed != 1 {
+			t.Errorf("expected the lines read before shutdown to be processed, got %v", stats)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ingest still waiting on the blocked read after cancellation")
+	}
+}
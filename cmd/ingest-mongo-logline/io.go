@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/url"
+	"sync"
+	"time"
 )
 
+// IO is an input or output location. Readers stop once their context is
+// done; writers use theirs to bound network calls made while writing and
+// flushing. Both must be closed to release resources and flush buffered
+// records.
 type IO interface {
-	Reader() (io.Reader, error)
-	Writer() (io.Writer, error)
+	Reader(ctx context.Context) (io.ReadCloser, error)
+	Writer(ctx context.Context) (io.WriteCloser, error)
 }
 
 type InitIO func(path string) IO
@@ -18,6 +25,8 @@ type registry map[string]InitIO
 var (
 	ErrAlreadyRegistered = errors.New("io: already registered")
 	ErrNotRegistered     = errors.New("io: not registered")
+	ErrReadNotSupported  = errors.New("io: reading not supported")
+	ErrWriteNotSupported = errors.New("io: writing not supported")
 )
 
 var r registry
@@ -40,6 +49,16 @@ func GetIO(source string) (IO, error) {
 		return nil, err
 	}
 	sourceName, sourcePath := path.Scheme, path.Host+path.Path
+	if sourceName == "http" || sourceName == "https" {
+		// urls are passed on as written, keeping their percent-encoding
+		sourcePath = path.Host + path.EscapedPath()
+	}
+	if path.User != nil {
+		sourcePath = path.User.String() + "@" + sourcePath
+	}
+	if path.RawQuery != "" {
+		sourcePath += "?" + path.RawQuery
+	}
 
 	fn, ok := r[sourceName]
 	if !ok {
@@ -47,3 +66,101 @@ func GetIO(source string) (IO, error) {
 	}
 	return fn(sourcePath), nil
 }
+
+// abandonReadAfter is how long a read pending when the context is done may
+// take to return before it is abandoned
+const abandonReadAfter = 100 * time.Millisecond
+
+// closeOnDone returns rc, closing it once ctx is done. Closing doesn't
+// interrupt a Read blocked on stdin, a pipe or a tty, so reads also stop
+// waiting once ctx is done, abandoning the pending read of rc.
+func closeOnDone(ctx context.Context, rc io.ReadCloser) io.ReadCloser {
+	c := &ctxReadCloser{ReadCloser: rc, ctx: ctx, done: make(chan struct{}), reads: make(chan readResult, 1)}
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Close()
+		case <-c.done:
+		}
+	}()
+	return c
+}
+
+type ctxReadCloser struct {
+	io.ReadCloser
+	ctx  context.Context
+	done chan struct{}
+	once sync.Once
+	err  error
+
+	// reads receives the result of the pending read, which reads into its
+	// own buffer as it may outlive the Read call that started it
+	reads   chan readResult
+	pending bool
+	buf     []byte
+	// rest holds the data of the last read not yet returned, and its error
+	rest    []byte
+	restErr error
+}
+
+type readResult struct {
+	data []byte
+	err  error
+}
+
+func (c *ctxReadCloser) Read(p []byte) (int, error) {
+	if len(c.rest) > 0 || c.restErr != nil {
+		return c.drain(p)
+	}
+	if !c.pending {
+		if cap(c.buf) < len(p) {
+			c.buf = make([]byte, len(p))
+		}
+		buf := c.buf[:len(p)]
+		c.pending = true
+		go func() {
+			n, err := c.ReadCloser.Read(buf)
+			c.reads <- readResult{buf[:n], err}
+		}()
+	}
+	select {
+	case r := <-c.reads:
+		return c.received(r, p)
+	case <-c.ctx.Done():
+		// closing interrupts most reads, which then return promptly, along
+		// with any data read just before ctx was done
+		select {
+		case r := <-c.reads:
+			return c.received(r, p)
+		case <-time.After(abandonReadAfter):
+			return 0, c.ctx.Err()
+		}
+	}
+}
+
+// received returns the result of the pending read.
+func (c *ctxReadCloser) received(r readResult, p []byte) (int, error) {
+	c.pending = false
+	c.rest, c.restErr = r.data, r.err
+	return c.drain(p)
+}
+
+// drain returns the data of the last read, then its error.
+func (c *ctxReadCloser) drain(p []byte) (int, error) {
+	n := copy(p, c.rest)
+	c.rest = c.rest[n:]
+	if len(c.rest) > 0 {
+		return n, nil
+	}
+	err := c.restErr
+	c.restErr = nil
+	return n, err
+}
+
+func (c *ctxReadCloser) Close() error {
+	c.once.Do(func() {
+		close(c.done)
+		c.err = c.ReadCloser.Close()
+	})
+	return c.err
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tmc/mongologtools/analysis"
+)
+
+const (
+	defaultMaxOpen = 64
+
+	// hiveDefaultPartition names the partition of records lacking the field
+	hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+)
+
+// partitionKeys are the fields records can be partitioned by, besides the
+// date and hour: the database of the namespace, the host and the record
+// fields with few distinct values
+var partitionKeys = map[string]bool{"db": true, "host": true, "ns": true, "op": true, "command_type": true, "component": true, "severity": true, "log_version": true}
+
+// dirio writes records into a Hive style directory tree partitioned by the
+// date and hour of their timestamp and optionally by further fields, e.g.
+//
+//	dir:///data/mongolog?by=db,host
+//
+// writes dt=2015-02-23/hour=03/db=shop/host=db1/part-0000.jsonl. The db
+// partition is taken from the namespace and host falls back to the syslog
+// host. max-open bounds the number of files kept open. Records must be json
+// objects, one per line.
+type dirio struct {
+	path string
+}
+
+func (d *dirio) Reader(ctx context.Context) (io.ReadCloser, error) {
+	return nil, ErrReadNotSupported
+}
+
+func (d *dirio) Writer(ctx context.Context) (io.WriteCloser, error) {
+	w := &partitionWriter{maxOpen: defaultMaxOpen, parts: map[string]string{}, files: map[string]*list.Element{}, lru: list.New()}
+	w.root = d.path
+	if idx := strings.IndexByte(d.path, '?'); idx >= 0 {
+		w.root = d.path[:idx]
+		query, err := url.ParseQuery(d.path[idx+1:])
+		if err != nil {
+			return nil, fmt.Errorf("dir: %v", err)
+		}
+		if v := query.Get("by"); v != "" {
+			for _, key := range strings.Split(v, ",") {
+				if key = strings.TrimSpace(key); !partitionKeys[key] {
+					return nil, fmt.Errorf("dir: unknown partition key %q", key)
+				}
+				w.by = append(w.by, key)
+			}
+		}
+		if v := query.Get("max-open"); v != "" {
+			if w.maxOpen, err = strconv.Atoi(v); err != nil || w.maxOpen < 1 {
+				return nil, fmt.Errorf("dir: invalid max-open %q", v)
+			}
+		}
+	}
+	if w.root == "" {
+		return nil, errors.New("dir: missing directory")
+	}
+	return w, nil
+}
+
+// partitionFile is an open part file of a partition
+type partitionFile struct {
+	dir  string
+	file *os.File
+}
+
+// partitionWriter routes json encoded records to the part file of their
+// partition, closing the least recently used file when too many are open.
+type partitionWriter struct {
+	root    string
+	by      []string
+	maxOpen int
+
+	partial []byte
+	// part file names chosen for partitions, kept when the file is closed
+	parts map[string]string
+	files map[string]*list.Element
+	lru   *list.List
+}
+
+func (w *partitionWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		idx := bytes.IndexByte(w.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := w.partial[:idx+1]
+		w.partial = w.partial[idx+1:]
+		if err := w.writeLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *partitionWriter) writeLine(line []byte) error {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil
+	}
+	var record map[string]interface{}
+	if err := json.Unmarshal(line, &record); err != nil || record == nil {
+		return fmt.Errorf("dir: records must be written as json objects, got %.40q", line)
+	}
+	f, err := w.file(w.partition(record))
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(line)
+	return err
+}
+
+// partition returns the directory of a record relative to the root.
+func (w *partitionWriter) partition(record map[string]interface{}) string {
+	dt, hour := hiveDefaultPartition, hiveDefaultPartition
+	if t, ok := analysis.ParseTimestamp(record["timestamp"]); ok {
+		t = t.UTC()
+		dt, hour = t.Format("2006-01-02"), t.Format("15")
+	}
+	parts := []string{"dt=" + dt, "hour=" + hour}
+	for _, key := range w.by {
+		parts = append(parts, key+"="+escapePartition(partitionValue(record, key)))
+	}
+	return filepath.Join(parts...)
+}
+
+// partitionValue returns the value of field key of a record.
+func partitionValue(record map[string]interface{}, key string) string {
+	var v interface{}
+	switch key {
+	case "db":
+		if ns, ok := record["ns"].(string); ok {
+			v = strings.SplitN(ns, ".", 2)[0]
+		}
+	case "host":
+		v = record["host"]
+		if syslog, ok := record["syslog"].(map[string]interface{}); ok && v == nil {
+			v = syslog["host"]
+		}
+	default:
+		v = record[key]
+	}
+	switch v := v.(type) {
+	case string:
+		if v != "" {
+			return v
+		}
+	case float64, bool:
+		return fmt.Sprint(v)
+	}
+	return hiveDefaultPartition
+}
+
+// escapePartition escapes the characters Hive does not allow in partition values.
+func escapePartition(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c < 0x20 || c == 0x7f || strings.IndexByte(`"#%'*/:=?\{[]^`, c) >= 0:
+			fmt.Fprintf(&buf, "%%%02X", c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+// file returns the open part file of a partition.
+func (w *partitionWriter) file(dir string) (*os.File, error) {
+	if e, ok := w.files[dir]; ok {
+		w.lru.MoveToFront(e)
+		return e.Value.(*partitionFile).file, nil
+	}
+	if w.lru.Len() >= w.maxOpen {
+		if err := w.closeFile(w.lru.Back()); err != nil {
+			return nil, err
+		}
+	}
+	path := filepath.Join(w.root, dir)
+	if err := os.MkdirAll(path, 0770); err != nil {
+		return nil, err
+	}
+	name, ok := w.parts[dir]
+	if !ok {
+		// leave the part files of earlier runs alone
+		for i := 0; ; i++ {
+			name = fmt.Sprintf("part-%04d.jsonl", i)
+			if !exists(filepath.Join(path, name)) {
+				break
+			}
+		}
+		w.parts[dir] = name
+	}
+	f, err := os.OpenFile(filepath.Join(path, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0660)
+	if err != nil {
+		return nil, err
+	}
+	w.files[dir] = w.lru.PushFront(&partitionFile{dir: dir, file: f})
+	return f, nil
+}
+
+func (w *partitionWriter) closeFile(e *list.Element) error {
+	pf := w.lru.Remove(e).(*partitionFile)
+	delete(w.files, pf.dir)
+	return pf.file.Close()
+}
+
+// Close writes any unterminated last record and closes all part files.
+func (w *partitionWriter) Close() error {
+	var err error
+	if len(bytes.TrimSpace(w.partial)) > 0 {
+		err = w.writeLine(append(w.partial, '\n'))
+	}
+	w.partial = nil
+	for w.lru.Len() > 0 {
+		if cerr := w.closeFile(w.lru.Front()); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func init() {
+	RegisterIO("dir", func(path string) IO {
+		return &dirio{path: path}
+	})
+}
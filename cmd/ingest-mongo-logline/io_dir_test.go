@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPartitionWriter(t *testing.T) {
+	root := t.TempDir()
+	output, err := GetIO("dir://" + root + "?by=db,host&max-open=1")
+	if err != nil {
+		t.Fatalf("error configuring output: %v", err)
+	}
+	write := func() {
+		w, err := output.Writer(context.Background())
+		if err != nil {
+			t.Fatalf("error opening output: %v", err)
+		}
+		out := json.NewEncoder(w)
+		for _, record := range []map[string]interface{}{
+			{"timestamp": "2015-02-23T03:20:19.670+0000", "ns": "shop.orders", "syslog": map[string]interface{}{"host": "db1"}},
+			{"timestamp": "2015-02-23T04:00:00.000+0000", "ns": "shop.users", "host": "db/2"},
+			{"timestamp": "2015-02-23T03:40:00.000+0000", "ns": "shop.orders", "syslog": map[string]interface{}{"host": "db1"}},
+			{"op": "query"},
+		} {
+			if err := out.Encode(record); err != nil {
+				t.Fatalf("error writing: %v", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("error closing: %v", err)
+		}
+	}
+	write()
+	write()
+
+	expected := map[string]int{
+		"dt=2015-02-23/hour=03/db=shop/host=db1/part-0000.jsonl":                                                                                      2,
+		"dt=2015-02-23/hour=03/db=shop/host=db1/part-0001.jsonl":                                                                                      2,
+		"dt=2015-02-23/hour=04/db=shop/host=db%2F2/part-0000.jsonl":                                                                                   1,
+		"dt=2015-02-23/hour=04/db=shop/host=db%2F2/part-0001.jsonl":                                                                                   1,
+		"dt=__HIVE_DEFAULT_PARTITION__/hour=__HIVE_DEFAULT_PARTITION__/db=__HIVE_DEFAULT_PARTITION__/host=__HIVE_DEFAULT_PARTITION__/part-0000.jsonl": 1,
+		"dt=__HIVE_DEFAULT_PARTITION__/hour=__HIVE_DEFAULT_PARTITION__/db=__HIVE_DEFAULT_PARTITION__/host=__HIVE_DEFAULT_PARTITION__/part-0001.jsonl": 1,
+	}
+	found := 0
+	filepath.Walk(root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		found++
+		rel, _ := filepath.Rel(root, path)
+		buf, _ := os.ReadFile(path)
+		if n, ok := expected[filepath.ToSlash(rel)]; !ok || strings.Count(string(buf), "\n") != n {
+			t.Errorf("%s: unexpected file with %q", rel, buf)
+		}
+		return nil
+	})
+	if found != len(expected) {
+		t.Errorf("expected %d part files, found %d", len(expected), found)
+	}
+}
+
+func TestPartitionWriterErrors(t *testing.T) {
+	root := t.TempDir()
+	output, _ := GetIO("dir://" + root + "?by=db,user")
+	if _, err := output.Writer(context.Background()); err == nil || !strings.Contains(err.Error(), `"user"`) {
+		t.Errorf("expected an error for an unknown partition key, got %v", err)
+	}
+
+	output, _ = GetIO("dir://" + root + "?by=db")
+	w, err := output.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("error opening output: %v", err)
+	}
+	if _, err := w.Write([]byte("Mon Feb 23 03:20:19.670 [conn1] end connection\n")); err == nil {
+		t.Error("expected an error for a line that isn't json")
+	}
+	if _, err := w.Write([]byte("{\n  \"op\": \"query\"\n}\n")); err == nil {
+		t.Error("expected an error for indented json")
+	}
+	w.Close()
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmc/mongologtools/analysis"
+)
+
+const (
+	defaultBulkSize    = 500
+	defaultBulkRetries = 5
+	defaultBulkBackoff = 100 * time.Millisecond
+)
+
+// matches the time layouts embedded in an index name, as in mongolog-{2006.01.02}
+var reIndexLayout = regexp.MustCompile(`\{[^}]+\}`)
+
+// esio writes records to an Elasticsearch or OpenSearch index using the _bulk api.
+//
+// The path names the index and may embed a Go time layout in braces which is
+// filled in from each record's timestamp, e.g.
+//
+//	es://localhost:9200/mongolog-{2006.01.02}?batch=1000&retries=3
+//
+// Supported query parameters are batch (documents per request), retries,
+// backoff (initial retry delay) and https.
+type esio struct {
+	path     string
+	endpoint string
+	index    string
+	batch    int
+	retries  int
+	backoff  time.Duration
+	client   *http.Client
+}
+
+// configure parses the index, endpoint and options from the path.
+func (e *esio) configure() error {
+	e.batch, e.retries, e.backoff = defaultBulkSize, defaultBulkRetries, defaultBulkBackoff
+	if e.client == nil {
+		e.client = http.DefaultClient
+	}
+	path := e.path
+	scheme, query := "http", url.Values{}
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		var err error
+		if query, err = url.ParseQuery(path[idx+1:]); err != nil {
+			return err
+		}
+		path = path[:idx]
+	}
+	if b, _ := strconv.ParseBool(query.Get("https")); b {
+		scheme = "https"
+	}
+	for name, dst := range map[string]*int{"batch": &e.batch, "retries": &e.retries} {
+		if v := query.Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return fmt.Errorf("es: invalid %s %q", name, v)
+			}
+			*dst = n
+		}
+	}
+	if v := query.Get("backoff"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("es: invalid backoff %q", v)
+		}
+		e.backoff = d
+	}
+	if e.batch == 0 {
+		e.batch = 1
+	}
+
+	idx := strings.IndexByte(path, '/')
+	if idx < 0 || idx == len(path)-1 {
+		return errors.New("es: missing index name")
+	}
+	e.endpoint = scheme + "://" + path[:idx] + "/_bulk"
+	e.index = path[idx+1:]
+	return nil
+}
+
+func (e *esio) Reader(ctx context.Context) (io.ReadCloser, error) {
+	return nil, ErrReadNotSupported
+}
+
+func (e *esio) Writer(ctx context.Context) (io.WriteCloser, error) {
+	if err := e.configure(); err != nil {
+		return nil, err
+	}
+	return &bulkWriter{e: e, ctx: ctx}, nil
+}
+
+// indexName returns the index a record with timestamp t is written to.
+func (e *esio) indexName(t time.Time) string {
+	return reIndexLayout.ReplaceAllStringFunc(e.index, func(layout string) string {
+		return t.UTC().Format(layout[1 : len(layout)-1])
+	})
+}
+
+type bulkDoc struct {
+	index  string
+	source []byte
+}
+
+// bulkWriter batches json encoded records into _bulk requests.
+type bulkWriter struct {
+	e        *esio
+	ctx      context.Context
+	partial  []byte
+	docs     []bulkDoc
+	rejected int
+}
+
+func (w *bulkWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		idx := bytes.IndexByte(w.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := bytes.TrimSpace(w.partial[:idx])
+		w.partial = w.partial[idx+1:]
+		if err := w.add(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *bulkWriter) add(line []byte) error {
+	if len(line) == 0 || bytes.Equal(line, []byte("null")) {
+		return nil
+	}
+	var record struct {
+		Timestamp interface{} `json:"timestamp"`
+	}
+	json.Unmarshal(line, &record)
+	t, ok := analysis.ParseTimestamp(record.Timestamp)
+	if !ok {
+		t = time.Now()
+	}
+	w.docs = append(w.docs, bulkDoc{index: w.e.indexName(t), source: append([]byte(nil), line...)})
+	if len(w.docs) >= w.e.batch {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush sends all buffered records, retrying with backoff while the cluster
+// is overloaded.
+func (w *bulkWriter) Flush() error {
+	docs := w.docs
+	w.docs = nil
+	backoff := w.e.backoff
+	for attempt := 0; len(docs) > 0; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff):
+			case <-w.ctx.Done():
+				return fmt.Errorf("es: %d documents not sent: %v", len(docs), w.ctx.Err())
+			}
+			backoff *= 2
+		}
+		retry, err := w.send(docs)
+		if err == nil {
+			docs = retry
+		}
+		if attempt == w.e.retries && len(docs) > 0 {
+			if err != nil {
+				return err
+			}
+			w.reject(docs, "retries exhausted")
+			return nil
+		}
+	}
+	return nil
+}
+
+// Close flushes remaining records and reports whether any were rejected.
+func (w *bulkWriter) Close() error {
+	if len(bytes.TrimSpace(w.partial)) > 0 {
+		w.add(bytes.TrimSpace(w.partial))
+	}
+	w.partial = nil
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if w.rejected > 0 {
+		return fmt.Errorf("es: %d documents rejected", w.rejected)
+	}
+	return nil
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	} `json:"items"`
+}
+
+// send issues one _bulk request, returning the documents that should be
+// retried. A non-nil error means the whole request should be retried.
+func (w *bulkWriter) send(docs []bulkDoc) ([]bulkDoc, error) {
+	var body bytes.Buffer
+	for _, doc := range docs {
+		meta, _ := json.Marshal(map[string]interface{}{"index": map[string]string{"_index": doc.index}})
+		body.Write(meta)
+		body.WriteByte('\n')
+		body.Write(doc.source)
+		body.WriteByte('\n')
+	}
+	req, err := http.NewRequestWithContext(w.ctx, "POST", w.e.endpoint, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := w.e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return nil, fmt.Errorf("es: bulk request: %s", resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		w.reject(docs, fmt.Sprintf("%s: %s", resp.Status, bytes.TrimSpace(msg)))
+		return nil, nil
+	}
+	var result bulkResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("es: decoding bulk response: %v", err)
+	}
+	if !result.Errors {
+		return nil, nil
+	}
+	var retry []bulkDoc
+	for i, item := range result.Items {
+		if i >= len(docs) {
+			break
+		}
+		for _, status := range item {
+			switch {
+			case status.Status == http.StatusTooManyRequests || status.Status >= 500:
+				retry = append(retry, docs[i])
+			case status.Status >= 300:
+				w.reject(docs[i:i+1], string(status.Error))
+			}
+		}
+	}
+	return retry, nil
+}
+
+func (w *bulkWriter) reject(docs []bulkDoc, reason string) {
+	for _, doc := range docs {
+		log.Printf("es: rejected document for index %s: %s: `%s..`\n", doc.index, reason, doc.source[:min(len(doc.source), 60)])
+	}
+	w.rejected += len(docs)
+}
+
+func init() {
+	for _, scheme := range []string{"es", "opensearch"} {
+		RegisterIO(scheme, func(path string) IO {
+			return &esio{path: path}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// bulkStandIn is a minimal stand-in for the Elasticsearch _bulk endpoint
+type bulkStandIn struct {
+	mu       sync.Mutex
+	requests int
+	indexed  map[string][]string
+}
+
+func (s *bulkStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if r.URL.Path != "/_bulk" {
+		http.NotFound(w, r)
+		return
+	}
+	// the first request is throttled as a whole
+	if s.requests == 1 {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+	var items []string
+	errors := false
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var meta struct {
+			Index struct {
+				Index string `json:"_index"`
+			} `json:"index"`
+		}
+		json.Unmarshal(scanner.Bytes(), &meta)
+		scanner.Scan()
+		doc := scanner.Text()
+		switch {
+		case strings.Contains(doc, `"op":"remove"`):
+			errors = true
+			items = append(items, `{"index":{"status":400,"error":{"type":"mapper_parsing_exception"}}}`)
+		case strings.Contains(doc, `"op":"update"`) && s.requests == 2:
+			// individual documents rejected by a full write queue
+			errors = true
+			items = append(items, `{"index":{"status":429,"error":{"type":"es_rejected_execution_exception"}}}`)
+		default:
+			s.indexed[meta.Index.Index] = append(s.indexed[meta.Index.Index], doc)
+			items = append(items, `{"index":{"status":201}}`)
+		}
+	}
+	fmt.Fprintf(w, `{"took":1,"errors":%v,"items":[%s]}`, errors, strings.Join(items, ","))
+}
+
+func TestBulkWriter(t *testing.T) {
+	standIn := &bulkStandIn{indexed: map[string][]string{}}
+	ts := httptest.NewServer(standIn)
+	defer ts.Close()
+
+	output, err := GetIO("es://" + strings.TrimPrefix(ts.URL, "http://") + "/mongolog-{2006.01.02}?batch=2&backoff=1ms")
+	if err != nil {
+		t.Fatalf("error configuring output: %v", err)
+	}
+	w, err := output.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("error opening output: %v", err)
+	}
+	out := json.NewEncoder(w)
+	for _, record := range []map[string]interface{}{
+		{"timestamp": "2015-02-23T03:20:19.670+0000", "op": "query"},
+		{"timestamp": "2015-02-23T23:59:59.999+0000", "op": "update"},
+		{"timestamp": "2015-02-24T00:00:00.000+0000", "op": "insert"},
+		{"timestamp": "2015-02-24T00:00:01.000+0000", "op": "remove"},
+		nil,
+		{"timestamp": "2015-02-25T10:00:00.000+0000", "op": "query"},
+	} {
+		if err := out.Encode(record); err != nil {
+			t.Fatalf("error writing: %v", err)
+		}
+	}
+	err = w.Close()
+	if err == nil || err.Error() != "es: 1 documents rejected" {
+		t.Errorf("expected one rejected document, got %v", err)
+	}
+
+	expected := map[string]int{"mongolog-2015.02.23": 2, "mongolog-2015.02.24": 1, "mongolog-2015.02.25": 1}
+	for index, n := range expected {
+		if len(standIn.indexed[index]) != n {
+			t.Errorf("%s: expected %d documents, got %d", index, n, len(standIn.indexed[index]))
+		}
+	}
+	if len(standIn.indexed) != len(expected) {
+		t.Errorf("unexpected indices: %v", standIn.indexed)
+	}
+}
+
+func TestBulkWriterConfig(t *testing.T) {
+	for _, path := range []string{"localhost:9200", "localhost:9200/", "localhost:9200/logs?batch=x"} {
+		e := &esio{path: path}
+		if _, err := e.Writer(context.Background()); err == nil {
+			t.Errorf("%s: expected a configuration error", path)
+		}
+	}
+}
@@ -1,30 +1,173 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// file output modes
+const (
+	fileOverwrite = "overwrite"
+	fileAppend    = "append"
+)
+
+// fileio reads and writes local files, "-" standing for stdin and stdout.
+//
+// Outputs accept the query parameters mode (overwrite or append, appending
+// by default when resuming from a checkpoint), max-size
+// (bytes, with an optional K, M or G suffix) and rotate (hourly, daily or a
+// duration) to roll the file by size or by record timestamp, and compress
+// to gzip closed segments, e.g.
+//
+//	file:///var/log/mongod.jsonl?rotate=hourly&compress=true
 type fileio struct {
-	path string
+	path  string
+	query url.Values
+	err   error
 }
 
-func (f *fileio) Reader() (io.Reader, error) {
+func (f *fileio) Reader(ctx context.Context) (io.ReadCloser, error) {
 	if f.path == "-" {
-		return os.Stdin, nil
+		return closeOnDone(ctx, os.Stdin), nil
+	}
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
 	}
-	return os.Open(f.path)
+	return closeOnDone(ctx, file), nil
 }
 
-func (f *fileio) Writer() (io.Writer, error) {
+// Resume opens the file at the checkpointed offset.
+func (f *fileio) Resume(ctx context.Context, cp *checkpoint) (io.ReadCloser, error) {
 	if f.path == "-" {
-		return os.Stdout, nil
+		return nil, errors.New("standard input cannot be resumed")
+	}
+	file, err := openCheckpointed(f.path, cp)
+	if err != nil {
+		return nil, err
+	}
+	return closeOnDone(ctx, file), nil
+}
+
+func (f *fileio) Writer(ctx context.Context) (io.WriteCloser, error) {
+	return f.writer(fileOverwrite)
+}
+
+// ResumeWriter opens the output of a run resumed from a checkpoint, which
+// appends to the records written by the previous runs. Overwriting would
+// discard them.
+func (f *fileio) ResumeWriter(ctx context.Context) (io.WriteCloser, error) {
+	if f.query.Get("mode") == fileOverwrite {
+		return nil, errors.New("file: mode=overwrite would discard the records written before the checkpoint")
+	}
+	return f.writer(fileAppend)
+}
+
+// writer opens the output in the configured mode, defaultMode if unset.
+func (f *fileio) writer(defaultMode string) (io.WriteCloser, error) {
+	if f.path == "-" {
+		return stdout{os.Stdout}, nil
+	}
+	if f.err != nil {
+		return nil, fmt.Errorf("file: %v", f.err)
+	}
+	mode := f.query.Get("mode")
+	if mode == "" {
+		mode = defaultMode
+	}
+	flags := os.O_CREATE | os.O_WRONLY
+	switch mode {
+	case fileOverwrite:
+		flags |= os.O_TRUNC
+	case fileAppend:
+		flags |= os.O_APPEND
+	default:
+		return nil, fmt.Errorf("file: unknown mode %q", mode)
+	}
+	maxSize, err := parseSize(f.query.Get("max-size"))
+	if err != nil {
+		return nil, fmt.Errorf("file: invalid max-size: %v", err)
+	}
+	period, err := parsePeriod(f.query.Get("rotate"))
+	if err != nil {
+		return nil, fmt.Errorf("file: invalid rotate: %v", err)
+	}
+	compress := false
+	if v := f.query.Get("compress"); v != "" {
+		if compress, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("file: invalid compress %q", v)
+		}
+	}
+	if maxSize == 0 && period == 0 {
+		return os.OpenFile(f.path, flags, 0660)
+	}
+	return newRotatingWriter(f.path, flags, maxSize, period, compress)
+}
+
+// stdout is left open on Close, as several outputs may share it
+type stdout struct {
+	*os.File
+}
+
+func (stdout) Close() error {
+	return nil
+}
+
+// parseSize parses a byte count such as 512, 64K or 100M.
+func parseSize(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	unit := int64(1)
+	switch strings.ToUpper(s[len(s)-1:]) {
+	case "K":
+		unit = 1 << 10
+	case "M":
+		unit = 1 << 20
+	case "G":
+		unit = 1 << 30
+	}
+	if unit > 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a positive size", s)
+	}
+	return n * unit, nil
+}
+
+// parsePeriod parses a rotation period: hourly, daily or a duration.
+func parsePeriod(s string) (time.Duration, error) {
+	switch s {
+	case "":
+		return 0, nil
+	case "hourly":
+		return time.Hour, nil
+	case "daily":
+		return 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%q is not a positive duration", s)
 	}
-	return os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY, 0660)
+	return d, nil
 }
 
 func init() {
 	RegisterIO("file", func(path string) IO {
-		return &fileio{path: path}
+		f := &fileio{path: path}
+		if idx := strings.IndexByte(path, '?'); idx >= 0 {
+			f.path = path[:idx]
+			f.query, f.err = url.ParseQuery(path[idx+1:])
+		}
+		return f
 	})
 }
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, s string) {
+	output, err := GetIO("file://" + path)
+	if err != nil {
+		t.Fatalf("error configuring output: %v", err)
+	}
+	w, err := output.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("error opening output: %v", err)
+	}
+	io.WriteString(w, s)
+	if err := w.Close(); err != nil {
+		t.Fatalf("error closing output: %v", err)
+	}
+}
+
+func TestFileModes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	writeFile(t, path, "a long first run\n")
+	writeFile(t, path, "second\n")
+	if buf, _ := os.ReadFile(path); string(buf) != "second\n" {
+		t.Errorf("expected overwrite to truncate, got %q", buf)
+	}
+	writeFile(t, path+"?mode=append", "third\n")
+	if buf, _ := os.ReadFile(path); string(buf) != "second\nthird\n" {
+		t.Errorf("expected append to keep existing lines, got %q", buf)
+	}
+
+	for _, query := range []string{"mode=update", "max-size=0", "max-size=10X", "rotate=weekly", "compress=maybe"} {
+		output, _ := GetIO("file://" + path + "?" + query)
+		if _, err := output.Writer(context.Background()); err == nil {
+			t.Errorf("%s: expected a configuration error", query)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxResumes bounds how many times a broken download is resumed with a range request
+const maxResumes = 5
+
+var flagHeaders headerFlags
+
+func init() {
+	flag.Var(&flagHeaders, "H", "extra `header` (\"Name: value\") sent with http(s) input requests, may be repeated")
+}
+
+type headerFlags []string
+
+func (h *headerFlags) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headerFlags) Set(value string) error {
+	if !strings.Contains(value, ":") {
+		return fmt.Errorf("header %q is not of the form \"Name: value\"", value)
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+func (h headerFlags) header() http.Header {
+	header := make(http.Header)
+	for _, v := range h {
+		parts := strings.SplitN(v, ":", 2)
+		header.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	return header
+}
+
+type httpio struct {
+	url    string
+	header http.Header
+	client *http.Client
+}
+
+func (h *httpio) Reader(ctx context.Context) (io.ReadCloser, error) {
+	r := &httpReader{h: h, ctx: ctx}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	if r.encoding == "gzip" {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+		return &gzipReadCloser{gz, r}, nil
+	}
+	return r, nil
+}
+
+func (h *httpio) Writer(ctx context.Context) (io.WriteCloser, error) {
+	return nil, ErrWriteNotSupported
+}
+
+func (h *httpio) get(ctx context.Context, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", h.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range h.header {
+		req.Header[k] = v
+	}
+	// asking for gzip explicitly disables transparent decompression so
+	// that byte offsets used for resumption refer to the transferred body
+	req.Header.Set("Accept-Encoding", "gzip")
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, fmt.Errorf("http: GET %s: %s", h.url, resp.Status)
+	}
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, errors.New("http: server ignored range request")
+	}
+	return resp, nil
+}
+
+// httpReader streams a response body, resuming with a range request when
+// the connection breaks before the body is complete.
+type httpReader struct {
+	h         *httpio
+	ctx       context.Context
+	body      io.ReadCloser
+	encoding  string
+	offset    int64
+	resumable bool
+	resumes   int
+}
+
+func (r *httpReader) open() error {
+	resp, err := r.h.get(r.ctx, r.offset)
+	if err != nil {
+		return err
+	}
+	encoding := resp.Header.Get("Content-Encoding")
+	if r.body != nil && encoding != r.encoding {
+		resp.Body.Close()
+		return errors.New("http: content encoding changed while resuming")
+	}
+	r.body, r.encoding = resp.Body, encoding
+	r.resumable = resp.Header.Get("Accept-Ranges") == "bytes" || resp.StatusCode == http.StatusPartialContent
+	return nil
+}
+
+func (r *httpReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	if err == nil || err == io.EOF || !r.resumable || r.resumes >= maxResumes || r.ctx.Err() != nil {
+		return n, err
+	}
+	r.body.Close()
+	r.resumes++
+	if oerr := r.open(); oerr != nil {
+		return n, fmt.Errorf("%v (resuming at byte %d: %v)", err, r.offset, oerr)
+	}
+	return n, nil
+}
+
+func (r *httpReader) Close() error {
+	return r.body.Close()
+}
+
+// gzipReadCloser closes the compressed body along with the decompressor
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.Closer
+}
+
+func (r *gzipReadCloser) Close() error {
+	r.Reader.Close()
+	return r.body.Close()
+}
+
+func init() {
+	for _, scheme := range []string{"http", "https"} {
+		scheme := scheme
+		RegisterIO(scheme, func(path string) IO {
+			return &httpio{
+				url:    scheme + "://" + path,
+				header: flagHeaders.header(),
+				client: http.DefaultClient,
+			}
+		})
+	}
+}
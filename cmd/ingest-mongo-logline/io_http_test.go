@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLog = "Mon Feb 23 03:20:19.670 [TTLMonitor] query local.system.indexes query: { expireAfterSeconds: { $exists: true } } ntoreturn:0 ntoskip:0 nscanned:0 keyUpdates:0 locks(micros) r:86 nreturned:0 reslen:20 0ms\n"
+
+func readHTTP(t *testing.T, url string) string {
+	input, err := GetIO(url)
+	if err != nil {
+		t.Fatalf("error configuring input: %v", err)
+	}
+	r, err := input.Reader(context.Background())
+	if err != nil {
+		t.Fatalf("error opening input: %v", err)
+	}
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("error reading input: %v", err)
+	}
+	return string(buf)
+}
+
+func TestHTTPReader(t *testing.T) {
+	flagHeaders = headerFlags{"Authorization: Bearer s3cret"}
+	defer func() { flagHeaders = nil }()
+
+	body := strings.Repeat(testLog, 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/plain", "/logs/a%2Fb%20c.log":
+			http.ServeContent(w, r, "mongod.log", time.Time{}, strings.NewReader(body))
+		case "/gzip":
+			var buf bytes.Buffer
+			gz := gzip.NewWriter(&buf)
+			gz.Write([]byte(body))
+			gz.Close()
+			w.Header().Set("Content-Encoding", "gzip")
+			http.ServeContent(w, r, "mongod.log", time.Time{}, bytes.NewReader(buf.Bytes()))
+		case "/broken":
+			if r.Header.Get("Range") != "" {
+				http.ServeContent(w, r, "mongod.log", time.Time{}, strings.NewReader(body))
+				return
+			}
+			// send half of the body, then drop the connection
+			w.Header().Set("Accept-Ranges", "bytes")
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			w.Write([]byte(body[:len(body)/2]))
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+	}))
+	defer ts.Close()
+
+	for _, path := range []string{"/plain", "/gzip", "/broken", "/logs/a%2Fb%20c.log"} {
+		if got := readHTTP(t, ts.URL+path); got != body {
+			t.Errorf("%s: got %d bytes, expected %d", path, len(got), len(body))
+		}
+	}
+}
+
+func TestHTTPReaderStatus(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	input, err := GetIO(ts.URL + "/missing.log")
+	if err != nil {
+		t.Fatalf("error configuring input: %v", err)
+	}
+	if _, err := input.Reader(context.Background()); err == nil {
+		t.Error("expected an error for a 404 response")
+	}
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSyslogMessage is the largest syslog message accepted over udp
+const maxSyslogMessage = 64 * 1024
+
+// syslogTimestamp is the 2.6 style timestamp prefixed to messages that arrive without one
+const syslogTimestamp = "2006-01-02T15:04:05.000-0700"
+
+var (
+	errSyslogFormat = errors.New("syslog: unrecognized message format")
+
+	// matches the start of a mongod message that carries its own timestamp
+	reMongoTimestamp = regexp.MustCompile(`^([A-Z][a-z]{2} [A-Z][a-z]{2} +[0-9]{1,2} |[0-9]{4}-[0-9]{2}-[0-9]{2}T)`)
+)
+
+// syslogio receives mongod log lines sent with --syslog to a syslog udp or tcp listener
+type syslogio struct {
+	network string
+	addr    string
+
+	listenAddr net.Addr
+}
+
+func (s *syslogio) Reader(ctx context.Context) (io.ReadCloser, error) {
+	pr, pw := io.Pipe()
+	var listener io.Closer
+	switch s.network {
+	case "udp":
+		conn, err := net.ListenPacket("udp", s.addr)
+		if err != nil {
+			return nil, err
+		}
+		s.listenAddr, listener = conn.LocalAddr(), conn
+		go s.serveUDP(ctx, conn, pw)
+	case "tcp":
+		l, err := net.Listen("tcp", s.addr)
+		if err != nil {
+			return nil, err
+		}
+		s.listenAddr, listener = l.Addr(), l
+		go s.serveTCP(ctx, l, pw)
+	default:
+		return nil, fmt.Errorf("syslog: unsupported protocol %q", s.network)
+	}
+	// closing the listener ends the stream once the messages in flight are delivered
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	return &syslogReader{pr, listener}, nil
+}
+
+func (s *syslogio) Writer(ctx context.Context) (io.WriteCloser, error) {
+	return nil, ErrWriteNotSupported
+}
+
+// syslogReader stops listening when closed
+type syslogReader struct {
+	*io.PipeReader
+	listener io.Closer
+}
+
+func (r *syslogReader) Close() error {
+	r.listener.Close()
+	return r.PipeReader.Close()
+}
+
+// closeStream ends the stream read from w, cleanly if it was stopped by ctx.
+func closeStream(ctx context.Context, w *io.PipeWriter, err error) {
+	if ctx.Err() != nil {
+		err = nil
+	}
+	w.CloseWithError(err)
+}
+
+func (s *syslogio) serveUDP(ctx context.Context, conn net.PacketConn, w *io.PipeWriter) {
+	defer conn.Close()
+	buf := make([]byte, maxSyslogMessage)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			closeStream(ctx, w, err)
+			return
+		}
+		if err := writeSyslogMessage(w, buf[:n]); err != nil {
+			return
+		}
+	}
+}
+
+func (s *syslogio) serveTCP(ctx context.Context, l net.Listener, w *io.PipeWriter) {
+	defer l.Close()
+	var (
+		mu    sync.Mutex
+		conns sync.WaitGroup
+	)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			conns.Wait()
+			closeStream(ctx, w, err)
+			return
+		}
+		conns.Add(1)
+		go func(conn net.Conn) {
+			defer conns.Done()
+			defer conn.Close()
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				select {
+				case <-ctx.Done():
+					conn.Close()
+				case <-done:
+				}
+			}()
+			r := bufio.NewReader(conn)
+			for {
+				msg, err := readSyslogFrame(r)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				err = writeSyslogMessage(w, msg)
+				mu.Unlock()
+				if err != nil {
+					l.Close()
+					return
+				}
+			}
+		}(conn)
+	}
+}
+
+// readSyslogFrame reads one message from a tcp stream framed either by
+// octet counting (RFC 6587) or by a trailing newline.
+func readSyslogFrame(r *bufio.Reader) ([]byte, error) {
+	c, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if c[0] >= '1' && c[0] <= '9' {
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil || n > maxSyslogMessage {
+			return nil, errSyslogFormat
+		}
+		msg := make([]byte, n)
+		_, err = io.ReadFull(r, msg)
+		return msg, err
+	}
+	msg, err := r.ReadBytes('\n')
+	if len(msg) > 0 && err == io.EOF {
+		err = nil
+	}
+	return msg, err
+}
+
+// writeSyslogMessage converts a syslog message to a log line and writes it
+// to w, skipping messages that aren't syslog formatted.
+func writeSyslogMessage(w io.Writer, msg []byte) error {
+	m, err := parseSyslog(msg)
+	if err != nil {
+		return nil
+	}
+	_, err = io.WriteString(w, m.logLine()+"\n")
+	return err
+}
+
+type syslogMessage struct {
+	priority  int
+	timestamp time.Time
+	host      string
+	app       string
+	pid       string
+	body      string
+}
+
+// parseSyslog parses an RFC 5424 or RFC 3164 formatted syslog message.
+func parseSyslog(msg []byte) (*syslogMessage, error) {
+	msg = bytes.TrimRight(msg, "\r\n\x00")
+	if len(msg) < 3 || msg[0] != '<' {
+		return nil, errSyslogFormat
+	}
+	end := bytes.IndexByte(msg, '>')
+	if end < 2 || end > 4 {
+		return nil, errSyslogFormat
+	}
+	priority, err := strconv.Atoi(string(msg[1:end]))
+	if err != nil || priority > 191 {
+		return nil, errSyslogFormat
+	}
+	rest := string(msg[end+1:])
+	m := &syslogMessage{priority: priority}
+	if strings.HasPrefix(rest, "1 ") {
+		err = m.parse5424(rest[2:])
+	} else {
+		err = m.parse3164(rest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parse5424 parses TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (m *syslogMessage) parse5424(s string) error {
+	fields := make([]string, 5)
+	for i := range fields {
+		idx := strings.IndexByte(s, ' ')
+		if idx < 0 {
+			return errSyslogFormat
+		}
+		fields[i], s = s[:idx], s[idx+1:]
+	}
+	if fields[0] != "-" {
+		ts, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return errSyslogFormat
+		}
+		m.timestamp = ts
+	}
+	m.host, m.app, m.pid = nilValue(fields[1]), nilValue(fields[2]), nilValue(fields[3])
+
+	// skip structured data
+	if strings.HasPrefix(s, "-") {
+		s = s[1:]
+	} else {
+		for strings.HasPrefix(s, "[") {
+			end := structuredDataEnd(s)
+			if end < 0 {
+				return errSyslogFormat
+			}
+			s = s[end+1:]
+		}
+	}
+	s = strings.TrimPrefix(s, " ")
+	m.body = strings.TrimPrefix(s, "\ufeff")
+	return nil
+}
+
+// structuredDataEnd returns the index of the ']' closing the element s starts with.
+func structuredDataEnd(s string) int {
+	inQuotes := false
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			inQuotes = !inQuotes
+		case ']':
+			if !inQuotes {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parse3164 parses TIMESTAMP HOSTNAME TAG[PID]: MSG
+func (m *syslogMessage) parse3164(s string) error {
+	if len(s) < len(time.Stamp)+1 {
+		return errSyslogFormat
+	}
+	ts, err := time.ParseInLocation(time.Stamp, s[:len(time.Stamp)], time.Local)
+	if err != nil {
+		return errSyslogFormat
+	}
+	m.timestamp = inferYear(ts, time.Now())
+	s = s[len(time.Stamp)+1:]
+
+	if idx := strings.IndexByte(s, ' '); idx > 0 {
+		m.host, s = s[:idx], s[idx+1:]
+	}
+	idx := strings.Index(s, ": ")
+	if idx < 0 {
+		m.body = s
+		return nil
+	}
+	tag := s[:idx]
+	m.body = s[idx+2:]
+	if open := strings.IndexByte(tag, '['); open > 0 && strings.HasSuffix(tag, "]") {
+		m.app, m.pid = tag[:open], tag[open+1:len(tag)-1]
+	} else {
+		m.app = tag
+	}
+	return nil
+}
+
+// inferYear places a year-less RFC 3164 timestamp in the year closest to now.
+func inferYear(ts, now time.Time) time.Time {
+	ts = ts.AddDate(now.Year()-ts.Year(), 0, 0)
+	if ts.After(now.Add(24 * time.Hour)) {
+		ts = ts.AddDate(-1, 0, 0)
+	}
+	return ts
+}
+
+func nilValue(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
+
+// logLine renders the message as a mongod log line with the syslog header
+// fields preserved in the leading metadata field understood by the parser.
+func (m *syslogMessage) logLine() string {
+	fields := []string{}
+	if m.host != "" {
+		fields = append(fields, "host: "+strconv.Quote(m.host))
+	}
+	if m.app != "" {
+		fields = append(fields, "app: "+strconv.Quote(m.app))
+	}
+	if pid, err := strconv.Atoi(m.pid); err == nil {
+		fields = append(fields, "pid: "+strconv.Itoa(pid))
+	} else if m.pid != "" {
+		fields = append(fields, "pid: "+strconv.Quote(m.pid))
+	}
+	fields = append(fields, "priority: "+strconv.Itoa(m.priority))
+
+	body := m.body
+	if !reMongoTimestamp.MatchString(body) && !m.timestamp.IsZero() {
+		body = m.timestamp.UTC().Format(syslogTimestamp) + " " + body
+	}
+	return "syslog: { " + strings.Join(fields, ", ") + " } " + body
+}
+
+func init() {
+	RegisterIO("syslog", func(path string) IO {
+		addr, network := path, "udp"
+		if idx := strings.IndexByte(path, '?'); idx >= 0 {
+			addr = path[:idx]
+			if q, err := url.ParseQuery(path[idx+1:]); err == nil && q.Get("proto") != "" {
+				network = q.Get("proto")
+			}
+		}
+		return &syslogio{network: network, addr: addr}
+	})
+}
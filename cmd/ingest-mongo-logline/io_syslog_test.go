@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+func TestParseSyslog(t *testing.T) {
+	now := time.Now().UTC().Format("Jan _2 15:04:05")
+	cases := []struct{ input, expected string }{
+		{
+			`<30>1 2026-10-18T14:03:01.123456Z db1.example.com mongod 4242 - - [conn12] query test.users query: { name: "bob" } 12ms`,
+			`syslog: { host: "db1.example.com", app: "mongod", pid: 4242, priority: 30 } 2026-10-18T14:03:01.123+0000 [conn12] query test.users query: { name: "bob" } 12ms`,
+		},
+		{
+			`<30>1 2026-10-18T16:03:01+02:00 db1 mongod - - [meta sequenceId="1"][origin ip="10.0.0.1"] I COMMAND  [conn1] command admin.$cmd command: isMaster { isMaster: 1 } 0ms`,
+			`syslog: { host: "db1", app: "mongod", priority: 30 } 2026-10-18T14:03:01.000+0000 I COMMAND  [conn1] command admin.$cmd command: isMaster { isMaster: 1 } 0ms`,
+		},
+		{
+			`<190>` + now + ` db2 mongod.27017[1234]: 2015-02-23T03:20:19.670+0000 [TTLMonitor] query local.system.indexes 0ms`,
+			`syslog: { host: "db2", app: "mongod.27017", pid: 1234, priority: 190 } 2015-02-23T03:20:19.670+0000 [TTLMonitor] query local.system.indexes 0ms`,
+		},
+	}
+	for i, testcase := range cases {
+		m, err := parseSyslog([]byte(testcase.input))
+		if err != nil {
+			t.Fatalf("case %d: error parsing: %v", i, err)
+		}
+		if result := m.logLine(); result != testcase.expected {
+			t.Errorf("case %d: expected '%s'\nbut got '%s'", i, testcase.expected, result)
+		}
+	}
+
+	for _, input := range []string{"", "no priority", "<999>1 - - - - - - msg", "<30>1 short"} {
+		if _, err := parseSyslog([]byte(input)); err == nil {
+			t.Errorf("expected an error parsing %q", input)
+		}
+	}
+}
+
+func TestSyslogListener(t *testing.T) {
+	for _, network := range []string{"udp", "tcp"} {
+		s := &syslogio{network: network, addr: "127.0.0.1:0"}
+		r, err := s.Reader(context.Background())
+		if err != nil {
+			t.Fatalf("%s: error listening: %v", network, err)
+		}
+		conn, err := net.Dial(network, s.listenAddr.String())
+		if err != nil {
+			t.Fatalf("%s: error dialing: %v", network, err)
+		}
+		msg := "<30>1 2015-02-23T03:20:19.670Z db1 mongod 4242 - - [TTLMonitor] query local.system.indexes query: { expireAfterSeconds: { $exists: true } } 0ms\n"
+		if _, err := conn.Write([]byte(msg)); err != nil {
+			t.Fatalf("%s: error sending: %v", network, err)
+		}
+		line, err := bufio.NewReader(r).ReadString('\n')
+		conn.Close()
+		if err != nil {
+			t.Fatalf("%s: error receiving: %v", network, err)
+		}
+
+		doc, err := parser.ParseLogLine(line[:len(line)-1])
+		if err != nil {
+			t.Fatalf("%s: error parsing %q: %v", network, line, err)
+		}
+		buf, _ := json.Marshal(doc)
+		expected := `{"context":"TTLMonitor","duration_ms":"0","log_version":"2.6","ns":"local.system.indexes","op":"query","query":{"expireAfterSeconds":{"$exists":true}},"syslog":{"app":"mongod","host":"db1","pid":4242,"priority":30},"timestamp":"2015-02-23T03:20:19.670+0000"}`
+		if string(buf) != expected {
+			t.Errorf("%s: expected '%s'\nbut got '%s'", network, expected, buf)
+		}
+	}
+}
+
+func TestSyslogShutdown(t *testing.T) {
+	for _, network := range []string{"udp", "tcp"} {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := &syslogio{network: network, addr: "127.0.0.1:0"}
+		r, err := s.Reader(ctx)
+		if err != nil {
+			t.Fatalf("%s: error listening: %v", network, err)
+		}
+		cancel()
+		if _, err := io.ReadAll(r); err != nil {
+			t.Errorf("%s: expected the stream to end cleanly, got %v", network, err)
+		}
+		r.Close()
+	}
+}
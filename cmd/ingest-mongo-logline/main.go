@@ -1,28 +1,75 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"flag"
 	"fmt"
+	"io"
+	"log"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 )
 
 var (
-	flagInput  = flag.String("i", "file://-", "input io path")
-	flagOutput = flag.String("o", "file://-", "output io path")
+	flagInput      = flag.String("i", "file://-", "input io path")
+	flagOutput     = flag.String("o", "file://-", "output io path")
+	flagFormat     = flag.String("format", "json", "output format: json or pretty")
+	flagColor      = flag.String("color", "auto", "colorize pretty output: auto, always or never")
+	flagSlowMS     = flag.Int("slow", 100, "duration in ms from which pretty output highlights operations as slow")
+	flagOnError    = flag.String("on-error", onErrorSkip, "handling of lines that fail to parse: skip, raw (emit a record holding the raw line) or dead-letter")
+	flagDeadLetter = flag.String("dead-letter", "", "io path receiving lines that fail to parse, unredacted, implies -on-error=dead-letter")
+	flagStrict     = flag.Bool("strict", false, "stop at the first line that fails to parse")
+	flagVersion    = flag.String("log-version", "", "treat lines not written in this log format as parse failures: 2.4, 2.6, 3.0, 3.2 (3.2 to 4.2) or 4.4 (json)")
+	flagSource     = flag.String("source-fields", "", "comma separated source metadata attached to each record: raw (the input line), input (the input io path), line (1-based line number), offset (byte offset of the line) and host (the ingesting host)")
+	flagRedact     = flag.String("redact", "", "replace literals in query, command and update documents with placeholders of the same type or keyed hashes: placeholder or hmac")
+	flagRedactKey  = flag.String("redact-key-file", "", "file holding the key for hmac redaction and namespace or address hashing")
+	flagRedactNS   = flag.Bool("redact-ns", false, "hash database and collection names")
+	flagRedactIP   = flag.Bool("redact-ip", false, "hash ip addresses")
+	flagRedactKeep = flag.String("redact-keep", "", "comma separated field names or dotted paths whose values are not redacted")
+	flagState      = flag.String("state", "", "checkpoint file used to resume a file input where a previous run stopped")
+	flagInterval   = flag.Duration("checkpoint-interval", 5*time.Second, "interval between checkpoint saves")
+	flagShutdown   = flag.Duration("shutdown-timeout", 10*time.Second, "time allowed after SIGINT or SIGTERM to flush and close outputs")
 )
 
 func main() {
 	flag.Parse()
 	if len(flag.Args()) != 0 {
-		fmt.Fprintf(os.Stderr, "unexpected argument(s):", flag.Args())
+		fmt.Fprintln(os.Stderr, "unexpected argument(s):", flag.Args())
 		os.Exit(1)
 	}
+	// input is stopped on the first signal, outputs are abandoned once the
+	// shutdown timeout expires or on a second signal
+	inputCtx, stopInput := context.WithCancel(context.Background())
+	outputCtx, stopOutput := context.WithCancel(context.Background())
+	go handleSignals(stopInput, stopOutput, *flagShutdown)
+
 	input, err := GetIO(*flagInput)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "error configurting input:", err)
 		os.Exit(1)
 	}
-	r, err := input.Reader()
+	var (
+		r  io.ReadCloser
+		cp *checkpoint
+	)
+	if *flagState != "" {
+		resumable, ok := input.(resumer)
+		if !ok {
+			fmt.Fprintln(os.Stderr, "error configuring input: -state requires a file input")
+			os.Exit(1)
+		}
+		if cp, err = loadCheckpoint(*flagState); err != nil {
+			fmt.Fprintln(os.Stderr, "error loading checkpoint:", err)
+			os.Exit(1)
+		}
+		r, err = resumable.Resume(inputCtx, cp)
+	} else {
+		r, err = input.Reader(inputCtx)
+	}
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "error opening input:", err)
 		os.Exit(1)
@@ -33,14 +80,112 @@ func main() {
 		fmt.Fprintln(os.Stderr, "error configurting output:", err)
 		os.Exit(1)
 	}
-	w, err := output.Writer()
+	w, err := openWriter(outputCtx, output, cp != nil)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "error opening output:", err)
 		os.Exit(1)
 	}
 
-	if err := ingest(r, w); err != nil {
-		fmt.Fprintln(os.Stderr, "error ingesting:", err)
+	color := *flagColor == "always" || (*flagColor == "auto" && isTerminal(w))
+	out, err := newEncoder(w, *flagFormat, color, *flagSlowMS)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error configuring output format:", err)
+		os.Exit(1)
+	}
+
+	if *flagRedact != "" {
+		var key []byte
+		if *flagRedactKey != "" {
+			if key, err = os.ReadFile(*flagRedactKey); err != nil {
+				fmt.Fprintln(os.Stderr, "error reading redaction key:", err)
+				os.Exit(1)
+			}
+			key = bytes.TrimSpace(key)
+		}
+		r, err := newRedactor(*flagRedact, key, *flagRedactNS, *flagRedactIP, strings.Split(*flagRedactKeep, ","))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error configuring redaction:", err)
+			os.Exit(1)
+		}
+		out = redactingEncoder{out, r}
+	}
+
+	opts := ingestOptions{onError: *flagOnError, strict: *flagStrict, version: *flagVersion}
+	if opts.source, err = parseSourceFields(*flagSource, *flagInput); err != nil {
+		fmt.Fprintln(os.Stderr, "error configuring source fields:", err)
 		os.Exit(1)
 	}
+	if cp != nil {
+		opts.source.lineBase, opts.source.offsetBase = cp.Line, cp.Offset
+	}
+	if *flagDeadLetter != "" {
+		deadLetter, err := GetIO(*flagDeadLetter)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error configuring dead-letter output:", err)
+			os.Exit(1)
+		}
+		if opts.deadLetter, err = openWriter(outputCtx, deadLetter, cp != nil); err != nil {
+			fmt.Fprintln(os.Stderr, "error opening dead-letter output:", err)
+			os.Exit(1)
+		}
+		opts.onError = onErrorDeadLetter
+	}
+
+	var checkpoints *checkpointer
+	if cp != nil {
+		checkpoints = newCheckpointer(*flagState, *flagInterval, cp, w, opts.deadLetter)
+		opts.progress = checkpoints.progress
+		opts.holdPartial = true
+	}
+
+	status := 0
+	stats, err := ingest(inputCtx, r, out, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error ingesting:", err)
+		status = 1
+	}
+	r.Close()
+	outputs := []io.Writer{w}
+	if opts.deadLetter != nil && opts.deadLetter != w {
+		outputs = append(outputs, opts.deadLetter)
+	}
+	flushed := true
+	for _, w := range outputs {
+		if c, ok := w.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				fmt.Fprintln(os.Stderr, "error closing output:", err)
+				status, flushed = 1, false
+			}
+		}
+	}
+	// the final position is only recorded once all outputs are flushed
+	if checkpoints != nil && flushed {
+		if err := checkpoints.save(); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			status = 1
+		}
+	}
+	log.Println(stats)
+	os.Exit(status)
+}
+
+// handleSignals stops the input on SIGINT or SIGTERM and the outputs once
+// timeout has passed or a second signal arrives. If the process still has
+// not exited a second later it is terminated.
+func handleSignals(stopInput, stopOutput func(), timeout time.Duration) {
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	sig := <-sigs
+	log.Printf("%v: stopping input and flushing outputs\n", sig)
+	stopInput()
+	select {
+	case <-time.After(timeout):
+		log.Printf("shutdown timeout of %v expired, abandoning outputs\n", timeout)
+	case sig = <-sigs:
+		log.Printf("%v: abandoning outputs\n", sig)
+	}
+	stopOutput()
+	time.Sleep(time.Second)
+	log.Println("forcing exit")
+	os.Exit(1)
 }
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ANSI escape sequences used by the pretty output
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiDim    = "\x1b[2m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiBlue   = "\x1b[34m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// fields rendered in, or implied by the layout of, the header line of a pretty record
+var headerFields = map[string]bool{
+	"timestamp":   true,
+	"severity":    true,
+	"component":   true,
+	"context":     true,
+	"op":          true,
+	"ns":          true,
+	"duration_ms": true,
+	"log_version": true,
+}
+
+// document fields rendered indented below the header, in order
+var documentFields = []string{"query", "command", "updateobj", "orderby", "planSummary", "exception"}
+
+type encoder interface {
+	Encode(v interface{}) error
+}
+
+// newEncoder returns the record encoder for the named output format.
+func newEncoder(w io.Writer, format string, color bool, slowMS int) (encoder, error) {
+	switch format {
+	case "json":
+		return json.NewEncoder(w), nil
+	case "pretty":
+		return &prettyEncoder{w: bufio.NewWriter(w), color: color, slowMS: slowMS}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+// isTerminal reports whether w is a character device such as a tty.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface {
+		Stat() (os.FileInfo, error)
+	})
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// prettyEncoder renders records for reading in a terminal: an aligned header
+// line, a line of remaining fields and the indented documents.
+type prettyEncoder struct {
+	w      *bufio.Writer
+	color  bool
+	slowMS int
+}
+
+func (e *prettyEncoder) paint(code, s string) string {
+	if !e.color || s == "" || code == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// paintPadded paints s and pads it with spaces to width columns, which the
+// escape codes would count towards if padded after painting.
+func (e *prettyEncoder) paintPadded(code, s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return e.paint(code, s) + strings.Repeat(" ", width-n)
+	}
+	return e.paint(code, s)
+}
+
+func (e *prettyEncoder) Encode(v interface{}) error {
+	record, ok := v.(map[string]interface{})
+	if !ok || record == nil {
+		return nil
+	}
+	str := func(key string) string {
+		s, _ := record[key].(string)
+		return s
+	}
+
+	var severityCode string
+	switch str("severity") {
+	case "E", "F":
+		severityCode = ansiRed + ansiBold
+	case "W":
+		severityCode = ansiYellow
+	case "D":
+		severityCode = ansiDim
+	}
+	fmt.Fprintf(e.w, "%s %s %-8s %-16s %s %s %s\n",
+		e.paint(ansiDim, str("timestamp")),
+		e.paintPadded(severityCode, str("severity"), 1),
+		str("component"),
+		"["+str("context")+"]",
+		e.paintPadded(ansiBold, str("op"), 8),
+		e.paintPadded(ansiCyan, str("ns"), 32),
+		e.duration(str("duration_ms")),
+	)
+
+	var keys []string
+	for key := range record {
+		if headerFields[key] || isDocumentField(key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if len(keys) > 0 {
+		fields := make([]string, len(keys))
+		for i, key := range keys {
+			buf, _ := json.Marshal(record[key])
+			fields[i] = e.paint(ansiBlue, key) + ":" + string(buf)
+		}
+		fmt.Fprintf(e.w, "    %s\n", strings.Join(fields, " "))
+	}
+
+	for _, key := range documentFields {
+		doc, ok := record[key]
+		if !ok {
+			continue
+		}
+		buf, err := json.MarshalIndent(doc, "    ", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(e.w, "    %s %s\n", e.paint(ansiBlue, key+":"), buf)
+	}
+	return e.w.Flush()
+}
+
+// duration renders the operation duration, highlighted once it reaches the slow threshold.
+func (e *prettyEncoder) duration(ms string) string {
+	if ms == "" {
+		return ""
+	}
+	n, err := strconv.Atoi(ms)
+	switch {
+	case err != nil:
+		return ms + "ms"
+	case n >= e.slowMS:
+		return e.paint(ansiRed+ansiBold, ms+"ms")
+	case n >= e.slowMS/2:
+		return e.paint(ansiYellow, ms+"ms")
+	}
+	return e.paint(ansiGreen, ms+"ms")
+}
+
+func isDocumentField(key string) bool {
+	for _, k := range documentFields {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
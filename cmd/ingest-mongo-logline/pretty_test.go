@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+func TestPrettyEncoder(t *testing.T) {
+	line := `2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { name: "bob", age: { $gt: 21 } } planSummary: COLLSCAN ntoreturn:0 nscanned:1000 nreturned:2 reslen:120 250ms`
+	record, err := parser.ParseLogLine(line)
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+
+	var buf bytes.Buffer
+	out, err := newEncoder(&buf, "pretty", false, 100)
+	if err != nil {
+		t.Fatalf("error creating encoder: %v", err)
+	}
+	if err := out.Encode(record); err != nil {
+		t.Fatalf("error encoding: %v", err)
+	}
+	out.Encode(map[string]interface{}(nil))
+	expected := `2015-02-23T03:20:19.670+0000 I QUERY    [conn12]         query    test.users                       250ms
+    nreturned:2 nscanned:1000 ntoreturn:0 reslen:120
+    query: {
+      "age": {
+        "$gt": 21
+      },
+      "name": "bob"
+    }
+    planSummary: [
+      {
+        "COLLSCAN": 1
+      }
+    ]
+`
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\nbut got\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	out, _ = newEncoder(&buf, "pretty", true, 100)
+	out.Encode(record)
+	if !strings.Contains(buf.String(), ansiRed+ansiBold+"250ms"+ansiReset) {
+		t.Errorf("expected the slow duration to be highlighted, got %q", buf.String())
+	}
+
+	if _, err := newEncoder(&buf, "xml", false, 100); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestPrettyColumns(t *testing.T) {
+	lines := []string{
+		`2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query test.users query: { name: "bob" } ntoreturn:0 nreturned:2 reslen:120 250ms`,
+		`2015-02-23T03:20:20.670+0000 W WRITE    [conn3] remove test.sessions query: { _id: 1 } ndeleted:1 3ms`,
+		`2015-02-23T03:20:21.670+0000 E COMMAND  [conn3] command admin.$cmd command: ping { ping: 1 } reslen:37 0ms`,
+	}
+	var buf bytes.Buffer
+	out, _ := newEncoder(&buf, "pretty", true, 100)
+	for _, line := range lines {
+		record, err := parser.ParseLogLine(line)
+		if err != nil {
+			t.Fatalf("error parsing: %v", err)
+		}
+		out.Encode(record)
+	}
+	// with the escape codes removed, the durations start in the same column
+	plain := regexp.MustCompile("\x1b\\[[0-9;]*m").ReplaceAllString(buf.String(), "")
+	column := -1
+	for _, line := range strings.Split(plain, "\n") {
+		if strings.HasPrefix(line, " ") || line == "" {
+			continue
+		}
+		i := strings.LastIndex(line, " ") + 1
+		if column >= 0 && i != column {
+			t.Errorf("misaligned header line, duration at column %d instead of %d:\n%s", i, column, plain)
+		}
+		column = i
+	}
+	if !strings.Contains(buf.String(), ansiBold+"query"+ansiReset+"    ") {
+		t.Errorf("expected the op to be padded after painting, got %q", buf.String())
+	}
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+	"github.com/tmc/mongologtools/parser"
+)
+
+// redaction modes
+const (
+	redactPlaceholder = "placeholder"
+	redactHMAC        = "hmac"
+)
+
+// fields holding documents or text with literal values from user data. The
+// originating command of a getMore holds the filter of its cursor.
+var redactedFields = []string{"query", "command", "originatingCommand", "update", "updateobj", "xextra", "raw"}
+
+// fields holding error messages, whose duplicate key values are redacted
+// separately: exception in text lines and errMsg in json lines
+var exceptionFields = []string{"exception", "errMsg"}
+
+// matches the document of a duplicate key exception, which holds the
+// duplicated values
+var reDupKey = regexp.MustCompile(`dup key: \{.*\}`)
+
+// matches a field value in the document syntax: a string, a constructor or
+// a bare literal such as a number
+var reFieldValue = regexp.MustCompile(`(:\s*)("(?:[^"\\]|\\.)*"|(?:new )?[A-Z][A-Za-z]*\([^)]*\)|[^\s,{}\[\]]+)`)
+
+// matches candidate ipv4 and ipv6 addresses, confirmed with net.ParseIP
+var reAddress = regexp.MustCompile(`[0-9]{1,3}(\.[0-9]{1,3}){3}|[0-9A-Fa-f]{0,4}(:[0-9A-Fa-f]{0,4}){2,7}`)
+
+// redactor replaces literals in the documents of a record, either with
+// placeholders of the same type or with keyed hashes which keep equal values
+// equal. It can also hash namespaces and ip addresses.
+type redactor struct {
+	mode string
+	key  []byte
+	ns   bool
+	ip   bool
+	// keep holds field names and dotted paths whose values are left as is
+	keep map[string]bool
+}
+
+func newRedactor(mode string, key []byte, ns, ip bool, keep []string) (*redactor, error) {
+	switch mode {
+	case redactPlaceholder:
+	case redactHMAC:
+		if len(key) == 0 {
+			return nil, errors.New("redact: the hmac mode requires a key")
+		}
+	default:
+		return nil, fmt.Errorf("redact: unknown mode %q", mode)
+	}
+	if (ns || ip) && len(key) == 0 {
+		return nil, errors.New("redact: hashing namespaces or addresses requires a key")
+	}
+	r := &redactor{mode: mode, key: key, ns: ns, ip: ip, keep: map[string]bool{}}
+	for _, field := range keep {
+		if field = strings.TrimSpace(field); field != "" {
+			r.keep[field] = true
+		}
+	}
+	return r, nil
+}
+
+// Redact rewrites a parsed record in place.
+func (r *redactor) Redact(record map[string]interface{}) {
+	for _, field := range redactedFields {
+		if v, ok := record[field]; ok {
+			record[field] = r.value(v, nil, r.keep[field])
+		}
+	}
+	for _, field := range exceptionFields {
+		if exception, ok := record[field].(string); ok && !r.keep[field] {
+			record[field] = r.exception(exception)
+		}
+	}
+	if ns, ok := record["ns"].(string); ok && r.ns {
+		record["ns"] = r.namespace(ns)
+	}
+	if r.ip {
+		for field, v := range record {
+			if field != "timestamp" {
+				record[field] = r.addresses(v)
+			}
+		}
+	}
+}
+
+// value redacts the literals of v, found at path, unless keep is set.
+func (r *redactor) value(v interface{}, path []string, keep bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, elem := range v {
+			elemPath := path
+			if !strings.HasPrefix(k, "$") {
+				elemPath = append(path[:len(path):len(path)], k)
+			}
+			v[k] = r.value(elem, elemPath, keep || r.keep[k] || r.keep[strings.Join(elemPath, ".")])
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = r.value(elem, path, keep)
+		}
+		return v
+	}
+	if keep {
+		return v
+	}
+	return r.literal(v)
+}
+
+// literal returns the redacted form of a scalar value.
+func (r *redactor) literal(v interface{}) interface{} {
+	hashed := r.mode == redactHMAC
+	switch v := v.(type) {
+	case string:
+		if hashed {
+			return hex.EncodeToString(r.sum("string", v)[:8])
+		}
+		return "?"
+	case int64:
+		if hashed {
+			return int64(binary.BigEndian.Uint64(r.sum("int", strconv.FormatInt(v, 10))) >> 1)
+		}
+		return int64(0)
+	case float64:
+		if hashed {
+			return float64(binary.BigEndian.Uint64(r.sum("float", strconv.FormatFloat(v, 'g', -1, 64)))>>11) / (1 << 53)
+		}
+		return float64(0)
+	case parser.Double:
+		if hashed {
+			return parser.Double(binary.BigEndian.Uint64(r.sum("float", strconv.FormatFloat(float64(v), 'g', -1, 64)))>>11) / (1 << 53)
+		}
+		return parser.Double(0)
+	case mongo_json.NumberLong:
+		if hashed {
+			return mongo_json.NumberLong(binary.BigEndian.Uint64(r.sum("long", strconv.FormatInt(int64(v), 10))) >> 1)
+		}
+		return mongo_json.NumberLong(0)
+	case mongo_json.NumberInt:
+		if hashed {
+			return mongo_json.NumberInt(binary.BigEndian.Uint32(r.sum("int32", strconv.Itoa(int(v)))) >> 1)
+		}
+		return mongo_json.NumberInt(0)
+	case mongo_json.NumberFloat:
+		if hashed {
+			return mongo_json.NumberFloat(float64(binary.BigEndian.Uint64(r.sum("float", strconv.FormatFloat(float64(v), 'g', -1, 64)))>>11) / (1 << 53))
+		}
+		return mongo_json.NumberFloat(0)
+	case mongo_json.ObjectId:
+		if hashed {
+			return mongo_json.ObjectId(hex.EncodeToString(r.sum("oid", strings.ToLower(string(v)))[:12]))
+		}
+		return mongo_json.ObjectId(strings.Repeat("0", 24))
+	case mongo_json.Date:
+		if hashed {
+			// keep hashed dates within the range of four digit years
+			return mongo_json.Date(binary.BigEndian.Uint64(r.sum("date", strconv.FormatInt(int64(v), 10))) % 253402300800000)
+		}
+		return mongo_json.Date(0)
+	case mongo_json.Timestamp:
+		if hashed {
+			sum := r.sum("timestamp", fmt.Sprintf("%d|%d", v.Seconds, v.Increment))
+			return mongo_json.Timestamp{Seconds: binary.BigEndian.Uint32(sum), Increment: binary.BigEndian.Uint32(sum[4:])}
+		}
+		return mongo_json.Timestamp{}
+	case mongo_json.BinData:
+		if hashed {
+			return mongo_json.BinData{Type: v.Type, Base64: base64.StdEncoding.EncodeToString(r.sum("bindata", v.Base64)[:16])}
+		}
+		return mongo_json.BinData{Type: v.Type, Base64: ""}
+	case mongo_json.RegExp:
+		if hashed {
+			return mongo_json.RegExp{Pattern: hex.EncodeToString(r.sum("regex", v.Pattern)[:8]), Options: v.Options}
+		}
+		return mongo_json.RegExp{Pattern: "?", Options: v.Options}
+	case parser.NumberDecimal:
+		if hashed {
+			return parser.NumberDecimal(strconv.FormatUint(binary.BigEndian.Uint64(r.sum("decimal", string(v)))>>1, 10))
+		}
+		return parser.NumberDecimal("0")
+	case parser.Symbol:
+		if hashed {
+			return parser.Symbol(hex.EncodeToString(r.sum("string", string(v))[:8]))
+		}
+		return parser.Symbol("?")
+	case parser.Code:
+		// the code of a $where or mapReduce function embeds its literals
+		code := "?"
+		if hashed {
+			code = hex.EncodeToString(r.sum("code", v.Code)[:8])
+		}
+		if v.Scope != nil {
+			r.value(v.Scope, nil, false)
+		}
+		return parser.Code{Code: code, Scope: v.Scope}
+	case parser.DBRef:
+		v.Id = r.value(v.Id, nil, false)
+		return v
+	case parser.DBPointer:
+		v.Id = r.literal(v.Id).(mongo_json.ObjectId)
+		return v
+	}
+	// booleans, null and the special key values carry no user data
+	return v
+}
+
+// exception redacts the values of the duplicate key document within an
+// exception message. Its fields are unnamed, so rather than parsing the
+// document each value is redacted on its own.
+func (r *redactor) exception(s string) string {
+	return reDupKey.ReplaceAllStringFunc(s, func(doc string) string {
+		return reFieldValue.ReplaceAllStringFunc(doc, func(field string) string {
+			m := reFieldValue.FindStringSubmatch(field)
+			return m[1] + r.literalText(m[2])
+		})
+	})
+}
+
+// literalText redacts a value written in the document syntax.
+func (r *redactor) literalText(s string) string {
+	doc, err := parser.ConvertLogToExtended([]byte("{ v: " + s + " }"))
+	if err != nil {
+		return `"?"`
+	}
+	buf, err := parser.FormatLogDoc(r.value(doc["v"], nil, false))
+	if err != nil {
+		return `"?"`
+	}
+	return string(buf)
+}
+
+func (r *redactor) sum(kind, value string) []byte {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// namespace hashes the database and collection names of ns, keeping the
+// internal databases, system collections and $cmd recognizable.
+func (r *redactor) namespace(ns string) string {
+	parts := strings.SplitN(ns, ".", 2)
+	for i, part := range parts {
+		if part == "$cmd" || part == "admin" || part == "local" || part == "config" || strings.HasPrefix(part, "system.") {
+			continue
+		}
+		parts[i] = hex.EncodeToString(r.sum("ns", part)[:8])
+	}
+	return strings.Join(parts, ".")
+}
+
+// addresses replaces the ip addresses in the strings of v with hashed
+// addresses of the same family.
+func (r *redactor) addresses(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return reAddress.ReplaceAllStringFunc(v, func(s string) string {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return s
+			}
+			sum := r.sum("ip", ip.String())
+			if ip.To4() != nil {
+				return net.IP(sum[:4]).String()
+			}
+			return net.IP(sum[:16]).String()
+		})
+	case map[string]interface{}:
+		for k, elem := range v {
+			v[k] = r.addresses(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = r.addresses(elem)
+		}
+	}
+	return v
+}
+
+// redactingEncoder redacts records before passing them on
+type redactingEncoder struct {
+	encoder
+	r *redactor
+}
+
+func (e redactingEncoder) Encode(v interface{}) error {
+	if record, ok := v.(map[string]interface{}); ok {
+		e.r.Redact(record)
+	}
+	return e.encoder.Encode(v)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+const redactLine = `2015-02-23T03:20:19.670+0000 I WRITE    [conn12] update shop.users query: { _id: ObjectId('54eaa6e3b6d2f0a1b8e7f003'), name: "bob", status: "A", age: { $gt: 21 } } update: { $set: { email: "bob@example.com", last: new Date(1424661619670) } } nscanned:1 nMatched:1 nModified:1 keyUpdates:0 numYields:0 locks(micros) w:120 3ms`
+
+func redacted(t *testing.T, r *redactor, line string) map[string]interface{} {
+	record, err := parser.ParseLogLine(line)
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+	r.Redact(record)
+	// round trip through json to compare plain values
+	buf, _ := json.Marshal(record)
+	var out map[string]interface{}
+	json.Unmarshal(buf, &out)
+	return out
+}
+
+func TestRedactPlaceholder(t *testing.T) {
+	r, err := newRedactor(redactPlaceholder, nil, false, false, []string{"status"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := redacted(t, r, redactLine)
+	buf, _ := json.Marshal(record["query"])
+	expected := `{"_id":{"$oid":"000000000000000000000000"},"age":{"$gt":0},"name":"?","status":"A"}`
+	if string(buf) != expected {
+		t.Errorf("expected query %s, got %s", expected, buf)
+	}
+	buf, _ = json.Marshal(record["update"])
+	expected = `{"$set":{"email":"?","last":{"$date":"1970-01-01T00:00:00.000Z"}}}`
+	if string(buf) != expected {
+		t.Errorf("expected update %s, got %s", expected, buf)
+	}
+	if record["ns"] != "shop.users" || record["nscanned"] != float64(1) {
+		t.Errorf("expected other fields to be kept, got %v", record)
+	}
+}
+
+func TestRedactCode(t *testing.T) {
+	r, err := newRedactor(redactPlaceholder, nil, false, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := redacted(t, r, `2015-02-23T03:20:19.670+0000 I QUERY    [conn12] query shop.users query: { $where: function () { return this.email == "bob@example.com"; }, owner: DBRef("users", 42), scoped: CodeWScope("this.a == x", { x: "secret" }) } nscanned:1 nreturned:1 3ms`)
+	buf, _ := json.Marshal(record["query"])
+	expected := `{"$where":{"$code":"?"},"owner":{"$id":0,"$ref":"users"},"scoped":{"$code":"?","$scope":{"x":"?"}}}`
+	if string(buf) != expected {
+		t.Errorf("expected query %s, got %s", expected, buf)
+	}
+}
+
+func TestRedactHMAC(t *testing.T) {
+	r, err := newRedactor(redactHMAC, []byte("secret"), true, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := redacted(t, r, redactLine)
+	second := redacted(t, r, strings.Replace(redactLine, `name: "bob"`, `name: "alice"`, 1))
+	q1, q2 := first["query"].(map[string]interface{}), second["query"].(map[string]interface{})
+	if q1["status"] != q2["status"] || q1["status"] == "A" {
+		t.Errorf("expected equal values to hash equally, got %v and %v", q1["status"], q2["status"])
+	}
+	if q1["name"] == q2["name"] {
+		t.Errorf("expected different values to hash differently, got %v", q1["name"])
+	}
+	if _, ok := q1["age"].(map[string]interface{})["$gt"].(float64); !ok {
+		t.Errorf("expected numbers to stay numbers, got %v", q1["age"])
+	}
+	ns := first["ns"].(string)
+	if ns == "shop.users" || strings.Count(ns, ".") != 1 {
+		t.Errorf("expected a hashed namespace, got %s", ns)
+	}
+
+	other, _ := newRedactor(redactHMAC, []byte("other"), false, false, nil)
+	if redacted(t, other, redactLine)["query"].(map[string]interface{})["status"] == q1["status"] {
+		t.Error("expected hashes to depend on the key")
+	}
+
+	record := map[string]interface{}{"timestamp": "2015-02-23T03:20:19.670+0000", "xextra": "end connection 10.0.0.12:53245 (1 connection now open)", "client": "10.0.0.12"}
+	r.ip, r.keep = true, map[string]bool{"xextra": true}
+	r.Redact(record)
+	client := record["client"].(string)
+	if client == "10.0.0.12" || !strings.Contains(record["xextra"].(string), client+":53245") {
+		t.Errorf("expected addresses to be hashed consistently, got %v", record)
+	}
+	if record["timestamp"] != "2015-02-23T03:20:19.670+0000" {
+		t.Errorf("expected the timestamp to be kept, got %v", record["timestamp"])
+	}
+
+	if _, err := newRedactor(redactHMAC, nil, false, false, nil); err == nil {
+		t.Error("expected an error for hmac redaction without a key")
+	}
+}
+
+func TestRedactException(t *testing.T) {
+	r, err := newRedactor(redactPlaceholder, nil, false, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := redacted(t, r, `2015-02-23T03:20:19.670+0000 I WRITE    [conn5] insert test.users query: { _id: 1 } ninserted:0 keyUpdates:0 exception: E11000 duplicate key error index: test.users.$email_1 dup key: { : "alice@example.com", : 42, : ObjectId('54e792daf1845f045f4c000e') } code:11000 numYields:0 1ms`)
+	expected := ` E11000 duplicate key error index: test.users.$email_1 dup key: { : "?", : 0, : ObjectId('000000000000000000000000') }`
+	if record["exception"] != expected {
+		t.Errorf("expected exception %q, got %q", expected, record["exception"])
+	}
+
+	h, _ := newRedactor(redactHMAC, []byte("secret"), false, false, nil)
+	record = redacted(t, h, `2019-03-14T12:00:00.000+0000 I WRITE    [conn5] update test.users query: { email: "bob@example.com" } exception: E11000 duplicate key error collection: test.users index: email_1 dup key: { email: "bob@example.com" } code:11000 0ms`)
+	exception, hashed := record["exception"].(string), record["query"].(map[string]interface{})["email"].(string)
+	if strings.Contains(exception, "bob@example.com") || !strings.Contains(exception, `dup key: { email: "`+hashed+`" }`) {
+		t.Errorf("expected the duplicate key to hash like the query, got %q and %q", exception, hashed)
+	}
+
+	h.keep = map[string]bool{"exception": true}
+	if record = redacted(t, h, `2019-03-14T12:00:00.000+0000 I WRITE    [conn5] update test.users query: { email: "bob@example.com" } exception: E11000 dup key: { email: "bob@example.com" } code:11000 0ms`); !strings.Contains(record["exception"].(string), "bob@example.com") {
+		t.Errorf("expected a kept exception, got %q", record["exception"])
+	}
+}
+
+func TestRedactGetMore(t *testing.T) {
+	r, err := newRedactor(redactPlaceholder, nil, false, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`2017-07-10T10:00:00.000+0000 I COMMAND  [conn1] command test.users command: getMore { getMore: 123456789, collection: "users" } originatingCommand: { find: "users", filter: { email: "alice@example.com" } } planSummary: COLLSCAN cursorid:123456789 keysExamined:0 docsExamined:10 nreturned:1 reslen:100 locks:{} protocol:op_command 5ms`,
+		`{"t":{"$date":"2020-08-05T12:00:00.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"test.users","command":{"getMore":123456789,"collection":"users","$db":"test"},"originatingCommand":{"find":"users","filter":{"email":"alice@example.com"},"$db":"test"},"planSummary":"COLLSCAN","errMsg":"E11000 duplicate key error collection: test.users index: email_1 dup key: { email: \"alice@example.com\" }","durationMillis":5}}`,
+	} {
+		record := redacted(t, r, line)
+		buf, _ := json.Marshal(record)
+		if strings.Contains(string(buf), "alice@example.com") {
+			t.Errorf("expected the originating command to be redacted, got %s", buf)
+		}
+		filter := record["originatingCommand"].(map[string]interface{})["filter"].(map[string]interface{})
+		if filter["email"] != "?" {
+			t.Errorf("unexpected filter %v", filter)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmc/mongologtools/analysis"
+)
+
+// segmentLabel is the time layout naming rotated segments
+const segmentLabel = "2006-01-02T15-04-05"
+
+// rotatingWriter writes lines to path, moving the file aside once it would
+// grow past maxSize or once a record's timestamp falls in a new period.
+// Rotated segments are named after the start of their period, or the time of
+// rotation, as in mongod-2015-02-23T03-00-00.jsonl, and gzipped if compress
+// is set.
+type rotatingWriter struct {
+	path     string
+	flags    int
+	maxSize  int64
+	period   time.Duration
+	compress bool
+
+	file    *os.File
+	size    int64
+	start   time.Time
+	partial []byte
+
+	compressing sync.WaitGroup
+	mu          sync.Mutex
+	err         error
+}
+
+func newRotatingWriter(path string, flags int, maxSize int64, period time.Duration, compress bool) (*rotatingWriter, error) {
+	w := &rotatingWriter{path: path, flags: flags, maxSize: maxSize, period: period, compress: compress}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *rotatingWriter) open() error {
+	f, err := os.OpenFile(w.path, w.flags, 0660)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.file, w.size = f, fi.Size()
+	return nil
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		idx := bytes.IndexByte(w.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := w.partial[:idx+1]
+		w.partial = w.partial[idx+1:]
+		if err := w.writeLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *rotatingWriter) writeLine(line []byte) error {
+	if w.period > 0 {
+		var record struct {
+			Timestamp interface{} `json:"timestamp"`
+		}
+		json.Unmarshal(line, &record)
+		if t, ok := analysis.ParseTimestamp(record.Timestamp); ok {
+			start := t.UTC().Truncate(w.period)
+			if !w.start.IsZero() && !start.Equal(w.start) {
+				if err := w.rotate(); err != nil {
+					return err
+				}
+			}
+			w.start = start
+		}
+	}
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	return err
+}
+
+// rotate moves the current file aside and opens a new one.
+func (w *rotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	if w.size > 0 {
+		name := w.segmentName()
+		if err := os.Rename(w.path, name); err != nil {
+			return err
+		}
+		if w.compress {
+			w.compressing.Add(1)
+			go func() {
+				defer w.compressing.Done()
+				if err := gzipFile(name); err != nil {
+					log.Printf("error compressing %s: %v\n", name, err)
+					w.mu.Lock()
+					if w.err == nil {
+						w.err = err
+					}
+					w.mu.Unlock()
+				}
+			}()
+		}
+	}
+	w.flags = w.flags&^os.O_APPEND | os.O_TRUNC
+	return w.open()
+}
+
+// segmentName returns an unused name for the segment being rotated.
+func (w *rotatingWriter) segmentName() string {
+	label := w.start
+	if label.IsZero() {
+		label = time.Now().UTC()
+	}
+	ext := filepath.Ext(w.path)
+	stem := strings.TrimSuffix(w.path, ext) + "-" + label.Format(segmentLabel)
+	name := stem + ext
+	for i := 1; exists(name) || exists(name+".gz"); i++ {
+		name = fmt.Sprintf("%s.%d%s", stem, i, ext)
+	}
+	return name
+}
+
+// Close writes any unterminated last line, closes the file and waits for
+// segments still being compressed.
+func (w *rotatingWriter) Close() error {
+	var err error
+	if len(w.partial) > 0 {
+		err = w.writeLine(w.partial)
+		w.partial = nil
+	}
+	if cerr := w.file.Close(); err == nil {
+		err = cerr
+	}
+	w.compressing.Wait()
+	if err == nil {
+		err = w.err
+	}
+	return err
+}
+
+func exists(name string) bool {
+	_, err := os.Lstat(name)
+	return err == nil
+}
+
+// gzipFile replaces name with a gzip compressed name.gz.
+func gzipFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(name+".gz", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0660)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	gz.Name = filepath.Base(name)
+	if _, err = io.Copy(gz, src); err == nil {
+		err = gz.Close()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(name + ".gz")
+		return err
+	}
+	return os.Remove(name)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func segments(t *testing.T, dir string) map[string]string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, entry := range entries {
+		f, err := os.Open(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r io.Reader = f
+		if strings.HasSuffix(entry.Name(), ".gz") {
+			if r, err = gzip.NewReader(f); err != nil {
+				t.Fatalf("%s: %v", entry.Name(), err)
+			}
+		}
+		buf, _ := io.ReadAll(r)
+		f.Close()
+		files[entry.Name()] = string(buf)
+	}
+	return files
+}
+
+func names(files map[string]string) []string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestRotateByTimestamp(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "out.jsonl")+"?rotate=hourly&compress=true",
+		`{"timestamp":"2015-02-23T03:20:19.670+0000","op":"query"}
+{"timestamp":"2015-02-23T03:59:59.999+0000","op":"update"}
+{"timestamp":"2015-02-23T04:00:00.000+0000","op":"insert"}
+{"timestamp":"2015-02-23T06:10:00.000+0000","op":"remove"}
+`)
+	files := segments(t, dir)
+	expected := map[string]int{
+		"out-2015-02-23T03-00-00.jsonl.gz": 2,
+		"out-2015-02-23T04-00-00.jsonl.gz": 1,
+		"out.jsonl":                        1,
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected segments %v, got %v", expected, names(files))
+	}
+	for name, n := range expected {
+		if strings.Count(files[name], "\n") != n {
+			t.Errorf("%s: expected %d records, got %q", name, n, files[name])
+		}
+	}
+}
+
+func TestRotateBySize(t *testing.T) {
+	dir := t.TempDir()
+	line := strings.Repeat("x", 9) + "\n"
+	writeFile(t, filepath.Join(dir, "out.log")+"?max-size=25", strings.Repeat(line, 5))
+	files := segments(t, dir)
+	if len(files) != 3 {
+		t.Fatalf("expected 3 segments, got %v", names(files))
+	}
+	for name, content := range files {
+		if len(content) > 25 || len(content)%len(line) != 0 {
+			t.Errorf("%s: unexpected content %q", name, content)
+		}
+	}
+	if files["out.log"] != line {
+		t.Errorf("expected the last record in the active file, got %q", files["out.log"])
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// source metadata that can be attached to records
+const (
+	sourceRaw    = "raw"
+	sourceInput  = "input"
+	sourceLine   = "line"
+	sourceOffset = "offset"
+	sourceHost   = "host"
+)
+
+// record fields holding the source metadata, the raw text is in parser.RawField
+const (
+	fieldSourceInput  = "source_input"
+	fieldSourceLine   = "source_line"
+	fieldSourceOffset = "source_offset"
+	fieldSourceHost   = "source_host"
+)
+
+// sourceOptions select the source metadata attached to each record, so that
+// a record can be traced back to the text it was parsed from.
+type sourceOptions struct {
+	raw, line, offset bool
+	// input and host, if set, name the input and the ingesting host
+	input, host string
+	// lineBase and offsetBase are the lines and bytes preceding the input,
+	// for inputs resumed from a checkpoint
+	lineBase, offsetBase int64
+}
+
+// parseSourceFields parses a comma separated list of source metadata names.
+// The input is named by inputPath and the host looked up if requested.
+func parseSourceFields(list, inputPath string) (sourceOptions, error) {
+	var o sourceOptions
+	for _, name := range strings.Split(list, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case sourceRaw:
+			o.raw = true
+		case sourceInput:
+			o.input = inputPath
+		case sourceLine:
+			o.line = true
+		case sourceOffset:
+			o.offset = true
+		case sourceHost:
+			host, err := os.Hostname()
+			if err != nil {
+				return o, fmt.Errorf("looking up the host name: %v", err)
+			}
+			o.host = host
+		default:
+			return o, fmt.Errorf("unknown source field %q", name)
+		}
+	}
+	return o, nil
+}
+
+// attach adds the selected metadata of the current line to record. The raw
+// text is recorded by the parser.
+func (o sourceOptions) attach(record map[string]interface{}, stats *ingestStats) {
+	if o.input != "" {
+		record[fieldSourceInput] = o.input
+	}
+	if o.line {
+		record[fieldSourceLine] = o.lineBase + int64(stats.parsed+stats.failed)
+	}
+	if o.offset {
+		record[fieldSourceOffset] = o.offsetBase + stats.start
+	}
+	if o.host != "" {
+		record[fieldSourceHost] = o.host
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSourceFields(t *testing.T) {
+	source, err := parseSourceFields("raw, input,line,offset,host", "file:///var/log/mongod.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var records bytes.Buffer
+	opts := ingestOptions{onError: onErrorRaw, source: source}
+	if _, err := ingest(context.Background(), strings.NewReader(ingestInput), json.NewEncoder(&records), opts); err != nil {
+		t.Fatal(err)
+	}
+	host, _ := os.Hostname()
+	lines := strings.SplitAfter(ingestInput, "\n")
+	d := json.NewDecoder(&records)
+	var offset int
+	for i, line := range lines[:3] {
+		var r map[string]interface{}
+		if err := d.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+		if r["raw"] != strings.TrimSuffix(line, "\n") || r["source_input"] != "file:///var/log/mongod.log" || r["source_host"] != host {
+			t.Errorf("line %d: unexpected record %v", i+1, r)
+		}
+		if r["source_line"] != float64(i+1) || r["source_offset"] != float64(offset) {
+			t.Errorf("line %d: expected line %d at offset %d, got %v and %v", i+1, i+1, offset, r["source_line"], r["source_offset"])
+		}
+		offset += len(line)
+	}
+
+	// resumed inputs continue the numbering of the checkpoint
+	records.Reset()
+	opts = ingestOptions{onError: onErrorSkip, source: sourceOptions{line: true, offset: true, lineBase: 10, offsetBase: 1000}}
+	if _, err := ingest(context.Background(), strings.NewReader(ingestInput), json.NewEncoder(&records), opts); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(records.String(), `"source_line":11,"source_offset":1000,`) || !strings.Contains(records.String(), `"source_line":13,"source_offset":1219,`) {
+		t.Errorf("unexpected records %s", records.String())
+	}
+	if strings.Contains(records.String(), `"raw"`) || strings.Contains(records.String(), "source_host") {
+		t.Errorf("unrequested fields in %s", records.String())
+	}
+
+	if _, err := parseSourceFields("line,path", "-"); err == nil {
+		t.Error("expected an error for an unknown source field")
+	}
+}
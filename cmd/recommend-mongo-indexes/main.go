@@ -0,0 +1,99 @@
+// Command recommend-mongo-indexes suggests compound indexes for the slow
+// operations of a log following the equality, sort, range rule, ranked by
+// the time spent in the operations each index would serve. Suggestions
+// already served by an index seen in a plan summary are flagged.
+//
+// Usage:
+//
+//	recommend-mongo-indexes [-slow ms] [-covered] [-format text|json] [file ...]
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+var (
+	flagSlowMS  = flag.Int64("slow", 100, "duration in ms from which operations are considered")
+	flagCovered = flag.Bool("covered", true, "include suggestions already served by an existing index")
+	flagFormat  = flag.String("format", "text", "output format: text or json")
+)
+
+func main() {
+	flag.Parse()
+	advisor := analysis.NewAdvisor(*flagSlowMS)
+	inputs := flag.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	for _, input := range inputs {
+		if err := read(input, advisor); err != nil {
+			fmt.Fprintln(os.Stderr, "error reading input:", err)
+			os.Exit(1)
+		}
+	}
+
+	var candidates []*analysis.IndexCandidate
+	for _, c := range advisor.Recommendations() {
+		if c.CoveredBy == "" || *flagCovered {
+			candidates = append(candidates, c)
+		}
+	}
+	var err error
+	switch *flagFormat {
+	case "text":
+		err = writeText(os.Stdout, candidates)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(candidates)
+	default:
+		err = fmt.Errorf("unknown output format %q", *flagFormat)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error writing recommendations:", err)
+		os.Exit(1)
+	}
+}
+
+func read(path string, advisor *analysis.Advisor) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for s.Scan() {
+		if record, err := parser.ParseLogLine(s.Text()); err == nil {
+			advisor.Add(record)
+		}
+	}
+	return s.Err()
+}
+
+func writeText(w io.Writer, candidates []*analysis.IndexCandidate) error {
+	bw := bufio.NewWriter(w)
+	for _, c := range candidates {
+		fmt.Fprintf(bw, "%s %s: %d operations, %dms", c.Namespace, c.Index, c.Count, c.Millis)
+		if c.CoveredBy != "" {
+			fmt.Fprintf(bw, " (covered by %s)", c.CoveredBy)
+		}
+		fmt.Fprintln(bw)
+		for _, shape := range c.Shapes {
+			fmt.Fprintf(bw, "  %s\n", shape)
+		}
+	}
+	return bw.Flush()
+}
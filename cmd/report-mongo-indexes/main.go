@@ -0,0 +1,102 @@
+// Command report-mongo-indexes reports, per namespace, the indexes used and
+// the collection scans performed according to the plan summaries of a log.
+//
+// Usage:
+//
+//	report-mongo-indexes [-top n] [-format text|json] [file ...]
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+var (
+	flagTop    = flag.Int("top", 5, "number of collection scan query shapes listed per namespace")
+	flagFormat = flag.String("format", "text", "output format: text or json")
+)
+
+func main() {
+	flag.Parse()
+	report := analysis.NewUsageReport()
+	inputs := flag.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	for _, input := range inputs {
+		if err := read(input, report); err != nil {
+			fmt.Fprintln(os.Stderr, "error reading input:", err)
+			os.Exit(1)
+		}
+	}
+
+	var err error
+	switch *flagFormat {
+	case "text":
+		err = writeText(os.Stdout, report)
+	case "json":
+		err = writeJSON(os.Stdout, report)
+	default:
+		err = fmt.Errorf("unknown output format %q", *flagFormat)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error writing report:", err)
+		os.Exit(1)
+	}
+}
+
+func read(path string, report *analysis.UsageReport) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for s.Scan() {
+		if record, err := parser.ParseLogLine(s.Text()); err == nil {
+			report.Add(record)
+		}
+	}
+	return s.Err()
+}
+
+func writeText(w io.Writer, report *analysis.UsageReport) error {
+	bw := bufio.NewWriter(w)
+	for _, u := range report.Namespaces() {
+		fmt.Fprintf(bw, "%s: %d operations, %d collection scans taking %dms\n", u.Namespace, u.Operations, u.CollScans, u.CollScanMillis)
+		for _, index := range u.Indexes() {
+			fmt.Fprintf(bw, "  index %s: used %d times, %dms\n", index.KeyPattern, index.Count, index.Millis)
+		}
+		for _, shape := range u.WorstCollScans(*flagTop) {
+			fmt.Fprintf(bw, "  collscan %s: %d times, %dms\n", shape.Shape, shape.Count, shape.Millis)
+		}
+	}
+	return bw.Flush()
+}
+
+func writeJSON(w io.Writer, report *analysis.UsageReport) error {
+	type namespace struct {
+		*analysis.NamespaceUsage
+		Indexes   []*analysis.IndexUse   `json:"indexes"`
+		CollScans []*analysis.ShapeStats `json:"collscan_shapes"`
+	}
+	var namespaces []namespace
+	for _, u := range report.Namespaces() {
+		namespaces = append(namespaces, namespace{u, u.Indexes(), u.WorstCollScans(*flagTop)})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(namespaces)
+}
@@ -0,0 +1,3 @@
+// Package generator produces synthetic MongoDB log lines in the formats of
+// several server versions, for tests and load generation
+package generator
@@ -0,0 +1,471 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+	"github.com/tmc/mongologtools/analysis"
+	"github.com/tmc/mongologtools/parser"
+)
+
+// Versions lists the supported log formats:
+//
+//	2.4  ctime timestamps
+//	2.6  iso8601 timestamps and plan summaries
+//	3.0  severity and component
+//	3.2  commands with locks documents and command metadata
+//	4.4  structured json
+var Versions = []string{"2.4", "2.6", "3.0", "3.2", "4.4"}
+
+// operation kinds of the op mix
+const (
+	OpQuery   = "query"
+	OpUpdate  = "update"
+	OpInsert  = "insert"
+	OpRemove  = "remove"
+	OpGetMore = "getmore"
+	OpCommand = "command"
+)
+
+// Config configures a Generator. Zero values select the defaults.
+type Config struct {
+	// Version is the server version whose log format is produced, see Versions
+	Version string
+	// Mix weighs the operation kinds, e.g. {"query": 5, "update": 1}
+	Mix map[string]int
+	// Namespaces are the namespaces operated on
+	Namespaces []string
+	// Fields are the field names of generated documents
+	Fields []string
+	// MaxPredicates bounds the number of fields of query filters
+	MaxPredicates int
+	// MinMillis and MaxMillis bound operation durations
+	MinMillis, MaxMillis int
+	// Start is the timestamp of the first line and Interval the mean time between lines
+	Start    time.Time
+	Interval time.Duration
+	// Seed seeds the random source, making the output reproducible
+	Seed int64
+}
+
+var defaultConfig = Config{
+	Version:       "3.0",
+	Mix:           map[string]int{OpQuery: 50, OpUpdate: 20, OpInsert: 10, OpRemove: 5, OpGetMore: 5, OpCommand: 10},
+	Namespaces:    []string{"test.users", "shop.orders", "shop.carts"},
+	Fields:        []string{"status", "age", "name", "created", "tags", "owner"},
+	MaxPredicates: 3,
+	MinMillis:     100,
+	MaxMillis:     2000,
+	Start:         time.Date(2015, 2, 23, 3, 20, 19, 670e6, time.UTC),
+	Interval:      100 * time.Millisecond,
+}
+
+// Generator produces log lines
+type Generator struct {
+	cfg     Config
+	r       *rand.Rand
+	t       time.Time
+	ops     []string
+	weights []int
+	total   int
+}
+
+// New returns a Generator for cfg.
+func New(cfg Config) (*Generator, error) {
+	if cfg.Version == "" {
+		cfg.Version = defaultConfig.Version
+	}
+	known := false
+	for _, v := range Versions {
+		known = known || v == cfg.Version
+	}
+	if !known {
+		return nil, fmt.Errorf("generator: unknown version %q", cfg.Version)
+	}
+	if len(cfg.Mix) == 0 {
+		cfg.Mix = defaultConfig.Mix
+	}
+	if len(cfg.Namespaces) == 0 {
+		cfg.Namespaces = defaultConfig.Namespaces
+	}
+	for _, ns := range cfg.Namespaces {
+		if !strings.Contains(ns, ".") {
+			return nil, fmt.Errorf("generator: invalid namespace %q", ns)
+		}
+	}
+	if len(cfg.Fields) == 0 {
+		cfg.Fields = defaultConfig.Fields
+	}
+	if cfg.MaxPredicates <= 0 {
+		cfg.MaxPredicates = defaultConfig.MaxPredicates
+	}
+	if cfg.MinMillis == 0 && cfg.MaxMillis == 0 {
+		cfg.MinMillis, cfg.MaxMillis = defaultConfig.MinMillis, defaultConfig.MaxMillis
+	}
+	if cfg.MinMillis < 0 || cfg.MaxMillis < cfg.MinMillis {
+		return nil, fmt.Errorf("generator: invalid duration range %d-%dms", cfg.MinMillis, cfg.MaxMillis)
+	}
+	if cfg.Start.IsZero() {
+		cfg.Start = defaultConfig.Start
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultConfig.Interval
+	}
+
+	g := &Generator{cfg: cfg, r: rand.New(rand.NewSource(cfg.Seed)), t: cfg.Start.UTC()}
+	for op := range cfg.Mix {
+		g.ops = append(g.ops, op)
+	}
+	sort.Strings(g.ops)
+	for _, op := range g.ops {
+		w := cfg.Mix[op]
+		switch op {
+		case OpQuery, OpUpdate, OpInsert, OpRemove, OpGetMore, OpCommand:
+		default:
+			return nil, fmt.Errorf("generator: unknown operation %q", op)
+		}
+		if w < 0 {
+			return nil, fmt.Errorf("generator: negative weight for %s", op)
+		}
+		g.total += w
+		g.weights = append(g.weights, g.total)
+	}
+	if g.total == 0 {
+		return nil, fmt.Errorf("generator: empty operation mix")
+	}
+	return g, nil
+}
+
+// operation is a generated operation, rendered according to the version
+type operation struct {
+	kind      string
+	ns        string
+	conn      int
+	filter    map[string]interface{}
+	update    map[string]interface{}
+	sort      map[string]interface{}
+	command   string
+	index     []string
+	examined  int
+	returned  int
+	millis    int
+	timestamp time.Time
+}
+
+// Line returns the next log line.
+func (g *Generator) Line() string {
+	op := g.operation()
+	switch g.cfg.Version {
+	case "2.4":
+		return g.line24(op)
+	case "2.6", "3.0":
+		return g.line26(op)
+	case "3.2":
+		return g.line32(op)
+	}
+	return g.line44(op)
+}
+
+func (g *Generator) operation() *operation {
+	n := g.r.Intn(g.total)
+	kind := g.ops[sort.SearchInts(g.weights, n+1)]
+	op := &operation{
+		kind:      kind,
+		ns:        g.cfg.Namespaces[g.r.Intn(len(g.cfg.Namespaces))],
+		conn:      1 + g.r.Intn(200),
+		millis:    g.cfg.MinMillis + g.r.Intn(g.cfg.MaxMillis-g.cfg.MinMillis+1),
+		timestamp: g.t,
+	}
+	// exponentially distributed gaps give a poisson arrival process
+	g.t = g.t.Add(time.Duration(g.r.ExpFloat64() * float64(g.cfg.Interval)))
+
+	if kind != OpInsert {
+		op.filter = g.filter()
+	}
+	switch kind {
+	case OpQuery, OpGetMore:
+		if g.r.Intn(3) == 0 {
+			op.sort = map[string]interface{}{g.field(): int64(1 - 2*g.r.Intn(2))}
+		}
+	case OpUpdate:
+		field := g.field()
+		op.update = map[string]interface{}{"$set": map[string]interface{}{field: g.literal(field)}}
+	case OpInsert:
+		op.update = g.document()
+	case OpCommand:
+		op.command = []string{"count", "aggregate", "distinct"}[g.r.Intn(3)]
+	}
+	// a query on an indexed field, or a collection scan
+	if g.r.Intn(2) == 0 && len(op.filter) > 0 {
+		for field := range op.filter {
+			op.index = append(op.index, field)
+		}
+		sort.Strings(op.index)
+		op.index = op.index[:1]
+		op.examined = 1 + g.r.Intn(100)
+	} else {
+		op.examined = 1000 + g.r.Intn(100000)
+	}
+	op.returned = g.r.Intn(op.examined + 1)
+	if op.returned > 101 {
+		op.returned = 101
+	}
+	return op
+}
+
+func (g *Generator) field() string {
+	return g.cfg.Fields[g.r.Intn(len(g.cfg.Fields))]
+}
+
+// literal returns a value for field. Each field name has a fixed value type.
+func (g *Generator) literal(field string) interface{} {
+	h := fnv.New32a()
+	h.Write([]byte(field))
+	switch h.Sum32() % 5 {
+	case 0:
+		return int64(g.r.Intn(100))
+	case 1:
+		return mongo_json.Date(g.t.UnixNano()/int64(time.Millisecond) - g.r.Int63n(1e10))
+	case 2:
+		oid := make([]byte, 24)
+		for i := range oid {
+			oid[i] = "0123456789abcdef"[g.r.Intn(16)]
+		}
+		return mongo_json.ObjectId(oid)
+	case 3:
+		return g.r.Intn(2) == 0
+	}
+	return []string{"A", "B", "C", "active", "pending"}[g.r.Intn(5)]
+}
+
+// filter returns a query filter of equality, range and $in predicates.
+func (g *Generator) filter() map[string]interface{} {
+	filter := map[string]interface{}{}
+	for i := 1 + g.r.Intn(g.cfg.MaxPredicates); i > 0; i-- {
+		switch g.r.Intn(8) {
+		case 0, 1:
+			field := g.field()
+			filter[field] = map[string]interface{}{[]string{"$gt", "$gte", "$lt", "$lte"}[g.r.Intn(4)]: g.literal(field)}
+		case 2:
+			field := g.field()
+			filter[field] = map[string]interface{}{"$in": []interface{}{g.literal(field), g.literal(field)}}
+		default:
+			field := g.field()
+			filter[field] = g.literal(field)
+		}
+	}
+	return filter
+}
+
+// document returns an inserted document.
+func (g *Generator) document() map[string]interface{} {
+	doc := map[string]interface{}{"_id": mongo_json.ObjectId(fmt.Sprintf("%024x", g.r.Int63()))}
+	for i := 1 + g.r.Intn(len(g.cfg.Fields)); i > 0; i-- {
+		field := g.field()
+		doc[field] = g.literal(field)
+	}
+	return doc
+}
+
+func (g *Generator) format(doc map[string]interface{}) string {
+	buf, _ := parser.FormatLogDoc(doc)
+	return string(buf)
+}
+
+func (op *operation) db() string {
+	return op.ns[:strings.IndexByte(op.ns, '.')]
+}
+
+func (op *operation) coll() string {
+	return op.ns[strings.IndexByte(op.ns, '.')+1:]
+}
+
+// planSummary renders the plan summary of a 2.6+ line.
+func (op *operation) planSummary() string {
+	if len(op.index) == 0 {
+		return "COLLSCAN"
+	}
+	return fmt.Sprintf("IXSCAN { %s: 1 }", op.index[0])
+}
+
+// commandDoc returns the command run by a command operation.
+func (op *operation) commandDoc() map[string]interface{} {
+	switch op.command {
+	case "aggregate":
+		return map[string]interface{}{"aggregate": op.coll(), "pipeline": []interface{}{map[string]interface{}{"$match": op.filter}}}
+	case "distinct":
+		return map[string]interface{}{"distinct": op.coll(), "key": "status", "query": op.filter}
+	}
+	return map[string]interface{}{"count": op.coll(), "query": op.filter}
+}
+
+// commandElems orders the fields of a command document with the command name first.
+func commandElems(name string, command map[string]interface{}) analysis.Doc {
+	keys := make([]string, 0, len(command))
+	for key := range command {
+		if key != name {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := command[name]; ok {
+		keys = append([]string{name}, keys...)
+	}
+	doc := make(analysis.Doc, len(keys))
+	for i, key := range keys {
+		doc[i] = analysis.Elem{Key: key, Value: command[key]}
+	}
+	return doc
+}
+
+func (g *Generator) line24(op *operation) string {
+	prefix := fmt.Sprintf("%s [conn%d] ", op.timestamp.Format("Mon Jan _2 15:04:05.000"), op.conn)
+	switch op.kind {
+	case OpQuery:
+		return prefix + fmt.Sprintf("query %s query: %s ntoreturn:0 ntoskip:0 nscanned:%d keyUpdates:0 locks(micros) r:%d nreturned:%d reslen:%d %dms",
+			op.ns, g.format(g.wrapped(op)), op.examined, op.millis*900, op.returned, 20+op.returned*100, op.millis)
+	case OpGetMore:
+		return prefix + fmt.Sprintf("getmore %s query: %s cursorid:%d ntoreturn:0 keyUpdates:0 locks(micros) r:%d nreturned:%d reslen:%d %dms",
+			op.ns, g.format(op.filter), g.r.Int63(), op.millis*900, op.returned, 20+op.returned*100, op.millis)
+	case OpUpdate:
+		return prefix + fmt.Sprintf("update %s query: %s update: %s nscanned:%d nupdated:1 keyUpdates:0 locks(micros) w:%d %dms",
+			op.ns, g.format(op.filter), g.format(op.update), op.examined, op.millis*900, op.millis)
+	case OpInsert:
+		return prefix + fmt.Sprintf("insert %s ninserted:1 keyUpdates:0 locks(micros) w:%d %dms", op.ns, op.millis*900, op.millis)
+	case OpRemove:
+		return prefix + fmt.Sprintf("remove %s query: %s ndeleted:1 keyUpdates:0 locks(micros) w:%d %dms",
+			op.ns, g.format(op.filter), op.millis*900, op.millis)
+	}
+	return prefix + fmt.Sprintf("command %s.$cmd command: %s ntoreturn:1 keyUpdates:0 locks(micros) r:%d reslen:48 %dms",
+		op.db(), g.format(op.commandDoc()), op.millis*900, op.millis)
+}
+
+// wrapped returns the filter of a legacy query, wrapped when it is sorted.
+func (g *Generator) wrapped(op *operation) map[string]interface{} {
+	if op.sort == nil {
+		return op.filter
+	}
+	return map[string]interface{}{"$query": op.filter, "$orderby": op.sort}
+}
+
+func (g *Generator) line26(op *operation) string {
+	prefix := op.timestamp.Format("2006-01-02T15:04:05.000-0700") + " "
+	if g.cfg.Version == "3.0" {
+		component := map[string]string{OpQuery: "QUERY", OpGetMore: "QUERY", OpUpdate: "WRITE", OpInsert: "WRITE", OpRemove: "WRITE", OpCommand: "COMMAND"}[op.kind]
+		prefix += fmt.Sprintf("I %-8s ", component)
+	}
+	prefix += fmt.Sprintf("[conn%d] ", op.conn)
+	locks := fmt.Sprintf("numYields:%d locks(micros) r:%d", op.examined/1000, op.millis*900)
+	if g.cfg.Version == "3.0" {
+		locks = fmt.Sprintf("numYields:%d locks:{ Global: { acquireCount: { r: 2 } }, Database: { acquireCount: { r: 1 } }, Collection: { acquireCount: { r: 1 } } }", op.examined/1000)
+	}
+	switch op.kind {
+	case OpQuery:
+		return prefix + fmt.Sprintf("query %s query: %s planSummary: %s ntoreturn:0 ntoskip:0 nscanned:%d nscannedObjects:%d keyUpdates:0 %s nreturned:%d reslen:%d %dms",
+			op.ns, g.format(g.wrapped(op)), op.planSummary(), op.examined, op.examined, locks, op.returned, 20+op.returned*100, op.millis)
+	case OpGetMore:
+		return prefix + fmt.Sprintf("getmore %s query: %s planSummary: %s cursorid:%d ntoreturn:0 keyUpdates:0 %s nreturned:%d reslen:%d %dms",
+			op.ns, g.format(op.filter), op.planSummary(), g.r.Int63(), locks, op.returned, 20+op.returned*100, op.millis)
+	case OpUpdate:
+		return prefix + fmt.Sprintf("update %s query: %s update: %s planSummary: %s nscanned:%d nscannedObjects:%d nMatched:1 nModified:1 keyUpdates:0 %s %dms",
+			op.ns, g.format(op.filter), g.format(op.update), op.planSummary(), op.examined, op.examined, locks, op.millis)
+	case OpInsert:
+		return prefix + fmt.Sprintf("insert %s query: %s ninserted:1 keyUpdates:0 %s %dms", op.ns, g.format(op.update), locks, op.millis)
+	case OpRemove:
+		return prefix + fmt.Sprintf("remove %s query: %s planSummary: %s ndeleted:1 keyUpdates:0 %s %dms",
+			op.ns, g.format(op.filter), op.planSummary(), locks, op.millis)
+	}
+	return prefix + fmt.Sprintf("command %s.$cmd command: %s %s planSummary: %s keyUpdates:0 %s reslen:48 %dms",
+		op.db(), op.command, g.format(op.commandDoc()), op.planSummary(), locks, op.millis)
+}
+
+func (g *Generator) line32(op *operation) string {
+	prefix := fmt.Sprintf("%s I %-8s [conn%d] ", op.timestamp.Format("2006-01-02T15:04:05.000-0700"), "COMMAND", op.conn)
+	name, command := op.command, op.commandDoc()
+	switch op.kind {
+	case OpQuery:
+		name, command = "find", map[string]interface{}{"find": op.coll(), "filter": op.filter}
+		if op.sort != nil {
+			command["sort"] = op.sort
+		}
+	case OpGetMore:
+		name, command = "getMore", map[string]interface{}{"getMore": g.r.Int63(), "collection": op.coll()}
+	case OpUpdate:
+		name, command = "update", map[string]interface{}{"update": op.coll(), "updates": []interface{}{map[string]interface{}{"q": op.filter, "u": op.update}}}
+	case OpInsert:
+		name, command = "insert", map[string]interface{}{"insert": op.coll(), "documents": []interface{}{op.update}}
+	case OpRemove:
+		name, command = "delete", map[string]interface{}{"delete": op.coll(), "deletes": []interface{}{map[string]interface{}{"q": op.filter, "limit": int64(0)}}}
+	}
+	return prefix + fmt.Sprintf("command %s command: %s %s planSummary: %s keysExamined:%d docsExamined:%d cursorExhausted:1 numYields:%d nreturned:%d reslen:%d locks:{ Global: { acquireCount: { r: 2 } }, Database: { acquireCount: { r: 1 } }, Collection: { acquireCount: { r: 1 } } } protocol:op_command %dms",
+		op.ns, name, g.format(command), op.planSummary(), len(op.index)*op.examined, op.examined, op.examined/1000, op.returned, 20+op.returned*100, op.millis)
+}
+
+// jsonLine is a 4.4 structured log line
+type jsonLine struct {
+	T    map[string]string      `json:"t"`
+	S    string                 `json:"s"`
+	C    string                 `json:"c"`
+	ID   int                    `json:"id"`
+	Ctx  string                 `json:"ctx"`
+	Msg  string                 `json:"msg"`
+	Attr map[string]interface{} `json:"attr"`
+}
+
+func (g *Generator) line44(op *operation) string {
+	attr := map[string]interface{}{
+		"type":           "command",
+		"ns":             op.ns,
+		"planSummary":    op.planSummary(),
+		"keysExamined":   len(op.index) * op.examined,
+		"docsExamined":   op.examined,
+		"numYields":      op.examined / 1000,
+		"nreturned":      op.returned,
+		"reslen":         20 + op.returned*100,
+		"protocol":       "op_msg",
+		"durationMillis": op.millis,
+		"locks":          map[string]interface{}{"Global": map[string]interface{}{"acquireCount": map[string]interface{}{"r": 2}}},
+	}
+	var (
+		command map[string]interface{}
+		name    string
+	)
+	switch op.kind {
+	case OpQuery, OpGetMore:
+		command, name = map[string]interface{}{"find": op.coll(), "filter": op.filter}, "find"
+		if op.sort != nil {
+			command["sort"] = op.sort
+		}
+	case OpUpdate:
+		command = map[string]interface{}{"q": op.filter, "u": op.update}
+		attr["type"] = "update"
+	case OpInsert:
+		command, name = map[string]interface{}{"insert": op.coll(), "documents": []interface{}{op.update}}, "insert"
+	case OpRemove:
+		command = map[string]interface{}{"q": op.filter, "limit": 0}
+		attr["type"] = "remove"
+	default:
+		command, name = op.commandDoc(), op.command
+	}
+	attr["command"] = append(commandElems(name, command), analysis.Elem{Key: "$db", Value: op.db()})
+	component := "COMMAND"
+	if attr["type"] != "command" {
+		component = "WRITE"
+	}
+	buf, _ := json.Marshal(jsonLine{
+		T:    map[string]string{"$date": op.timestamp.Format("2006-01-02T15:04:05.000-07:00")},
+		S:    "I",
+		C:    component,
+		ID:   51803,
+		Ctx:  fmt.Sprintf("conn%d", op.conn),
+		Msg:  "Slow query",
+		Attr: attr,
+	})
+	return string(buf)
+}
@@ -0,0 +1,71 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/tmc/mongologtools/generator"
+	"github.com/tmc/mongologtools/parser"
+)
+
+func TestGeneratedLinesParse(t *testing.T) {
+	for _, version := range generator.Versions {
+		g, err := generator.New(generator.Config{Version: version, Seed: 42})
+		if err != nil {
+			t.Fatalf("%s: error creating generator: %v", version, err)
+		}
+		ops := map[string]int{}
+		for i := 0; i < 500; i++ {
+			line := g.Line()
+			record, err := parser.ParseLogLineVersion(line, version)
+			if err != nil {
+				t.Fatalf("%s: error parsing %s: %v", version, line, err)
+			}
+			if record["ns"] == nil || record["timestamp"] == nil {
+				t.Fatalf("%s: incomplete record %v for %s", version, record, line)
+			}
+			if _, ok := record["duration_ms"]; !ok {
+				t.Fatalf("%s: missing duration for %s", version, line)
+			}
+			if extra, ok := record["xextra"]; ok {
+				t.Fatalf("%s: unparsed %q in %s", version, extra, line)
+			}
+			kind, _ := record["op"].(string)
+			if version == "3.2" || kind == "command" {
+				// queries are logged as commands from 3.2
+				kind, _ = record["command_type"].(string)
+			}
+			ops[kind]++
+		}
+		if len(ops) < 3 {
+			t.Errorf("%s: expected a mix of operations, got %v", version, ops)
+		}
+	}
+}
+
+func TestGeneratorConfig(t *testing.T) {
+	a, _ := generator.New(generator.Config{Seed: 7})
+	b, _ := generator.New(generator.Config{Seed: 7})
+	for i := 0; i < 10; i++ {
+		if la, lb := a.Line(), b.Line(); la != lb {
+			t.Fatalf("expected equal seeds to generate equal lines, got\n%s\n%s", la, lb)
+		}
+	}
+
+	g, _ := generator.New(generator.Config{Mix: map[string]int{generator.OpRemove: 1}, Namespaces: []string{"a.b"}, MinMillis: 5, MaxMillis: 5})
+	record, err := parser.ParseLogLine(g.Line())
+	if err != nil || record["op"] != "remove" || record["ns"] != "a.b" || record["duration_ms"] != "5" {
+		t.Errorf("expected the configured operation, got %v (%v)", record, err)
+	}
+
+	for _, cfg := range []generator.Config{
+		{Version: "1.8"},
+		{Mix: map[string]int{"explain": 1}},
+		{Mix: map[string]int{generator.OpQuery: 0}},
+		{Namespaces: []string{"users"}},
+		{MinMillis: 10, MaxMillis: 5},
+	} {
+		if _, err := generator.New(cfg); err == nil {
+			t.Errorf("expected an error for %+v", cfg)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Package bsonconv converts the shell type values of MongoDB log documents
+// to the types of the Go driver's bson/primitive package, for callers that
+// pass parsed documents on to the driver. It is kept apart from the parser
+// so that only its importers depend on the driver.
+package bsonconv
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+// Converter converts values to the bson/primitive types: dates to
+// primitive.DateTime, ObjectIds to primitive.ObjectID, decimals to
+// primitive.Decimal128, DBRefs to primitive.D and so on. Long and int
+// numbers become int64 and int32, as the driver encodes them.
+var Converter parser.ValueConverter = parser.ValueConverterFunc(convert)
+
+func convert(kind string, args ...interface{}) (interface{}, error) {
+	if err := parser.CheckConverterArgs(kind, args); err != nil {
+		return nil, err
+	}
+	switch kind {
+	case parser.KindDate:
+		return primitive.DateTime(args[0].(int64)), nil
+	case parser.KindObjectId:
+		oid, err := primitive.ObjectIDFromHex(args[0].(string))
+		if err != nil {
+			return nil, fmt.Errorf("bsonconv: invalid ObjectId %q", args[0])
+		}
+		return oid, nil
+	case parser.KindBinData:
+		data, err := base64.StdEncoding.DecodeString(args[1].(string))
+		if err != nil {
+			return nil, fmt.Errorf("bsonconv: invalid BinData: %v", err)
+		}
+		return primitive.Binary{Subtype: args[0].(byte), Data: data}, nil
+	case parser.KindTimestamp:
+		return primitive.Timestamp{T: args[0].(uint32), I: args[1].(uint32)}, nil
+	case parser.KindNumberLong, parser.KindNumberInt:
+		return args[0], nil
+	case parser.KindNumberDecimal:
+		d, err := primitive.ParseDecimal128(args[0].(string))
+		if err != nil {
+			return nil, fmt.Errorf("bsonconv: invalid NumberDecimal %q", args[0])
+		}
+		return d, nil
+	case parser.KindRegex:
+		return primitive.Regex{Pattern: args[0].(string), Options: args[1].(string)}, nil
+	case parser.KindMinKey:
+		return primitive.MinKey{}, nil
+	case parser.KindMaxKey:
+		return primitive.MaxKey{}, nil
+	case parser.KindUndefined:
+		return primitive.Undefined{}, nil
+	case parser.KindCode:
+		code, scope := args[0].(string), args[1].(map[string]interface{})
+		if scope == nil {
+			return primitive.JavaScript(code), nil
+		}
+		return primitive.CodeWithScope{Code: primitive.JavaScript(code), Scope: scope}, nil
+	case parser.KindDBRef:
+		// the driver has no DBRef type, the reference is a document
+		ref := primitive.D{{Key: "$ref", Value: args[0]}, {Key: "$id", Value: args[1]}}
+		if db := args[2].(string); db != "" {
+			ref = append(ref, primitive.E{Key: "$db", Value: db})
+		}
+		return ref, nil
+	case parser.KindDBPointer:
+		oid, err := primitive.ObjectIDFromHex(args[1].(string))
+		if err != nil {
+			return nil, fmt.Errorf("bsonconv: invalid DBPointer id %q", args[1])
+		}
+		return primitive.DBPointer{DB: args[0].(string), Pointer: oid}, nil
+	case parser.KindSymbol:
+		return primitive.Symbol(args[0].(string)), nil
+	}
+	return nil, fmt.Errorf("bsonconv: unsupported value %s(...)", kind)
+}
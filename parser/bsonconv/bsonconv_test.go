@@ -0,0 +1,54 @@
+package bsonconv_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/tmc/mongologtools/parser"
+	"github.com/tmc/mongologtools/parser/bsonconv"
+)
+
+func TestConverter(t *testing.T) {
+	input := `{ d: new Date(1424661619670), o: ObjectId('54e792daf1845f045f4c000e'), b: BinData(0, "aGVsbG8="), t: Timestamp(1424661619, 1), l: NumberLong(7), i: NumberInt(3), n: NumberDecimal("1.10"), r: /^ab/i, c: Code("x"), s: CodeWScope(function () { return x; }, { x: 1 }), ref: DBRef("users", ObjectId('54e792daf1845f045f4c000e'), "test"), p: DBPointer("test.users", ObjectId('54e792daf1845f045f4c000e')), y: Symbol("sym"), min: MinKey, max: MaxKey, u: undefined }`
+	doc, err := parser.ConvertLogToExtendedWithOptions([]byte(input), parser.ParseOptions{Converter: bsonconv.Converter})
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+	oid, _ := primitive.ObjectIDFromHex("54e792daf1845f045f4c000e")
+	decimal, _ := primitive.ParseDecimal128("1.10")
+	expected := map[string]interface{}{
+		"d":   primitive.NewDateTimeFromTime(time.Date(2015, 2, 23, 3, 20, 19, 670e6, time.UTC)),
+		"o":   oid,
+		"b":   primitive.Binary{Subtype: 0, Data: []byte("hello")},
+		"t":   primitive.Timestamp{T: 1424661619, I: 1},
+		"l":   int64(7),
+		"i":   int32(3),
+		"n":   decimal,
+		"r":   primitive.Regex{Pattern: "^ab", Options: "i"},
+		"c":   primitive.JavaScript("x"),
+		"s":   primitive.CodeWithScope{Code: "function () { return x; }", Scope: map[string]interface{}{"x": int64(1)}},
+		"ref": primitive.D{{Key: "$ref", Value: "users"}, {Key: "$id", Value: oid}, {Key: "$db", Value: "test"}},
+		"p":   primitive.DBPointer{DB: "test.users", Pointer: oid},
+		"y":   primitive.Symbol("sym"),
+		"min": primitive.MinKey{},
+		"max": primitive.MaxKey{},
+		"u":   primitive.Undefined{},
+	}
+	for k, v := range expected {
+		if !reflect.DeepEqual(doc[k], v) {
+			t.Errorf("%s: expected %#v, got %#v", k, v, doc[k])
+		}
+	}
+
+	for _, input := range []string{`{ n: NumberDecimal("one") }`, `{ a: Point(1, 2) }`} {
+		if _, err := parser.ConvertLogToExtendedWithOptions([]byte(input), parser.ParseOptions{Converter: bsonconv.Converter}); err == nil {
+			t.Errorf("%s: expected an error", input)
+		}
+	}
+	if _, err := bsonconv.Converter.Convert(parser.KindObjectId, int64(1)); err == nil {
+		t.Error("expected an error for a wrong argument type")
+	}
+}
@@ -1,81 +1,298 @@
 package logdoc
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
-
-	mongo_json "github.com/mongodb/mongo-tools/common/json"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
+// Numeric converts integers to int64, and numbers with a fraction or an
+// exponent, or out of the int64 range, to float64. Doubles with an integral
+// value, which json would write like integers, and NaN and the infinities,
+// including overflowing literals, which it can't write at all, are
+// converted to Double.
 func (p *LogDoc) Numeric(value string) interface{} {
+	unsigned := strings.TrimPrefix(value, "-")
+	switch unsigned {
+	case "NaN", "nan":
+		return Double(math.NaN())
+	case "Infinity", "inf":
+		if unsigned != value {
+			return Double(math.Inf(-1))
+		}
+		return Double(math.Inf(1))
+	}
+	if strings.HasPrefix(unsigned, "0x") || strings.HasPrefix(unsigned, "0X") {
+		if i64, err := strconv.ParseInt(value, 0, 64); err == nil {
+			return i64
+		}
+		// like decimal literals, overflowing hex literals become doubles
+		i, _ := new(big.Int).SetString(value, 0)
+		f64, _ := new(big.Float).SetInt(i).Float64()
+		return Double(f64)
+	}
 	n := json.Number(value)
 	if i64, err := n.Int64(); err == nil {
 		return i64
 	}
 	f64, _ := n.Float64()
+	if math.IsInf(f64, 0) || f64 == math.Trunc(f64) {
+		return Double(f64)
+	}
 	return f64
 }
 
-func (d *LogDoc) Date(value string) mongo_json.Date {
-	n, _ := strconv.Atoi(value)
-	return mongo_json.Date(n)
+func (d *LogDoc) Date(value string) interface{} {
+	n, _ := strconv.ParseInt(value, 10, 64)
+	return d.convert(KindDate, n)
+}
+
+// layouts of the date strings accepted by ISODate and new Date
+var isoDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
 }
 
-func (d *LogDoc) ObjectId(value string) mongo_json.ObjectId {
-	return mongo_json.ObjectId(value)
+func (d *LogDoc) ISODate(value string) interface{} {
+	// example: ISODate("2015-02-23T03:20:19.670Z")
+	for _, layout := range isoDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return d.convert(KindDate, t.Unix()*1e3+int64(t.Nanosecond())/int64(time.Millisecond))
+		}
+	}
+	d.fail(fmt.Errorf("log_doc: invalid date %q", value))
+	return nil
+}
+
+func (d *LogDoc) ObjectId(value string) interface{} {
+	return d.convert(KindObjectId, value)
 }
 
-func (d *LogDoc) Bindata(value string) mongo_json.BinData {
+func (d *LogDoc) Bindata(value string) interface{} {
 	// example: BinData(0,"aGVsbG8K")
 	parts := strings.Split(value, ",")
-	binType, _ := strconv.Atoi(strings.TrimSpace(parts[0]))
-	data := strings.Trim(parts[1], `"`)
-	return mongo_json.BinData{
-		Type:   byte(binType),
-		Base64: data,
+	binType, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 8)
+	if err != nil || len(parts) != 2 {
+		d.fail(fmt.Errorf("log_doc: invalid BinData(%s)", value))
+		return nil
+	}
+	return d.convert(KindBinData, byte(binType), unquote(parts[1]))
+}
+
+func (d *LogDoc) Uuid(value string) interface{} {
+	// example: UUID("0123abcd-0000-4000-8000-0123456789ab")
+	b, err := hex.DecodeString(strings.Replace(unquote(value), "-", "", -1))
+	if err != nil || len(b) != 16 {
+		d.fail(fmt.Errorf("log_doc: invalid UUID %q", unquote(value)))
+		return nil
 	}
+	return d.convert(KindBinData, byte(4), base64.StdEncoding.EncodeToString(b))
+}
 
+func (d *LogDoc) Hexdata(value string) interface{} {
+	// example: HexData(0,"68656c6c6f")
+	parts := strings.Split(value, ",")
+	binType, _ := strconv.Atoi(strings.TrimSpace(parts[0]))
+	var b []byte
+	if len(parts) == 2 {
+		var err error
+		if b, err = hex.DecodeString(unquote(parts[1])); err != nil {
+			d.fail(fmt.Errorf("log_doc: invalid HexData %q", unquote(parts[1])))
+			return nil
+		}
+	}
+	return d.convert(KindBinData, byte(binType), base64.StdEncoding.EncodeToString(b))
 }
 
-func (d *LogDoc) Timestamp(value string) mongo_json.Timestamp {
+func (d *LogDoc) Timestamp(value string) interface{} {
 	parts := strings.Split(value, ",")
 	if len(parts) != 2 {
 		parts = strings.Split(value, "|")
 	}
 	if len(parts) != 2 {
-		return mongo_json.Timestamp{}
+		d.fail(fmt.Errorf("log_doc: invalid Timestamp %q", value))
+		return nil
+	}
+	seconds, err1 := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 32)
+	increment, err2 := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+	if err1 != nil || err2 != nil {
+		d.fail(fmt.Errorf("log_doc: invalid Timestamp %q", value))
+		return nil
 	}
-	p1, p2 := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
-	seconds, _ := strconv.ParseUint(p1, 10, 0)
-	increment, _ := strconv.ParseUint(p2, 10, 0)
-	return mongo_json.Timestamp{
-		Seconds:   uint32(seconds),
-		Increment: uint32(increment),
+	return d.convert(KindTimestamp, uint32(seconds), uint32(increment))
+}
+
+func (d *LogDoc) Numberlong(value string) interface{} {
+	n, err := strconv.ParseInt(unquote(value), 10, 64)
+	if err != nil {
+		d.fail(fmt.Errorf("log_doc: invalid NumberLong %q", unquote(value)))
+		return nil
+	}
+	return d.convert(KindNumberLong, n)
+}
+
+func (d *LogDoc) Numberint(value string) interface{} {
+	n, err := strconv.ParseInt(unquote(value), 10, 32)
+	if err != nil {
+		d.fail(fmt.Errorf("log_doc: invalid NumberInt %q", unquote(value)))
+		return nil
 	}
+	return d.convert(KindNumberInt, int32(n))
 }
 
-func (d *LogDoc) Numberlong(value string) mongo_json.NumberLong {
-	n, _ := strconv.ParseInt(value, 10, 0)
-	return mongo_json.NumberLong(n)
+func (d *LogDoc) Numberdecimal(value string) interface{} {
+	// example: NumberDecimal("1.10")
+	return d.convert(KindNumberDecimal, unquote(value))
 }
-func (d *LogDoc) Regex(value string) mongo_json.RegExp {
+
+func (d *LogDoc) Regex(value string) interface{} {
 	slashIdx := strings.LastIndex(value, "/")
 	pattern, options := value[:slashIdx], value[slashIdx+1:]
-	return mongo_json.RegExp{
-		Pattern: pattern,
-		Options: options,
+	return d.convert(KindRegex, pattern, options)
+}
+
+func (d *LogDoc) Minkey() interface{} {
+	return d.convert(KindMinKey)
+}
+
+func (d *LogDoc) Maxkey() interface{} {
+	return d.convert(KindMaxKey)
+}
+
+func (d *LogDoc) Undefined() interface{} {
+	return d.convert(KindUndefined)
+}
+
+// Unescape decodes the backslash escapes of a quoted string, including \xNN
+// bytes. Unknown escapes are kept as written.
+func (d *LogDoc) Unescape(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i == len(value)-1 {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			r, n := unescapeRune(value[i+1:])
+			if n == 0 {
+				b.WriteString(`\u`)
+				continue
+			}
+			b.WriteRune(r)
+			i += n
+		case 'x':
+			if i+3 > len(value) {
+				b.WriteString(`\x`)
+				continue
+			}
+			n, err := strconv.ParseUint(value[i+1:i+3], 16, 8)
+			if err != nil {
+				b.WriteString(`\x`)
+				continue
+			}
+			b.WriteByte(byte(n))
+			i += 2
+		case '"', '\'', '\\', '/':
+			b.WriteByte(value[i])
+		default:
+			// unknown escapes are kept as written
+			b.WriteByte('\\')
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String()
+}
+
+// unescapeRune decodes the hex digits following a \u escape, combining
+// surrogate pairs, and returns the rune and the number of bytes consumed.
+func unescapeRune(s string) (rune, int) {
+	if len(s) < 4 {
+		return 0, 0
+	}
+	n, err := strconv.ParseUint(s[:4], 16, 16)
+	if err != nil {
+		return 0, 0
+	}
+	r := rune(n)
+	if utf16.IsSurrogate(r) && len(s) >= 10 && s[4:6] == `\u` {
+		if n2, err := strconv.ParseUint(s[6:10], 16, 16); err == nil {
+			if pair := utf16.DecodeRune(r, rune(n2)); pair != utf8.RuneError {
+				return pair, 10
+			}
+		}
+	}
+	return r, 4
+}
+
+// unquote strips whitespace and the single or double quotes around a constructor argument
+func unquote(value string) string {
+	return strings.Trim(strings.TrimSpace(value), `"'`)
+}
+
+func (d *LogDoc) Javascript(value string) interface{} {
+	return d.convert(KindCode, value, map[string]interface{}(nil))
+}
+
+func (d *LogDoc) CodeWScope() interface{} {
+	// example: CodeWScope( function () { return x; }, { x: 1 })
+	scope, _ := d.PopValue().(map[string]interface{})
+	code, _ := d.PopValue().(string)
+	return d.convert(KindCode, code, scope)
+}
+
+func (d *LogDoc) DBRef(withDatabase bool) interface{} {
+	// example: DBRef("users", ObjectId("54e792daf1845f045f4c000e"), "test")
+	var database string
+	if withDatabase {
+		database, _ = d.PopValue().(string)
 	}
+	id := d.PopValue()
+	collection, _ := d.PopValue().(string)
+	return d.convert(KindDBRef, collection, id, database)
 }
 
-func (d *LogDoc) Minkey() mongo_json.MinKey {
-	return mongo_json.MinKey{}
+func (d *LogDoc) DBPointer() interface{} {
+	// example: DBPointer("test.users", ObjectId("54e792daf1845f045f4c000e"))
+	id, _ := d.PopValue().(string)
+	namespace, _ := d.PopValue().(string)
+	return d.convert(KindDBPointer, namespace, id)
 }
 
-func (d *LogDoc) Maxkey() mongo_json.MaxKey {
-	return mongo_json.MaxKey{}
+func (d *LogDoc) Symbol(value string) interface{} {
+	return d.convert(KindSymbol, value)
 }
 
-func (d *LogDoc) Undefined() mongo_json.Undefined {
-	return mongo_json.Undefined{}
+// Constructor converts a value of the form Name(args...) that isn't a known
+// shell type. It is only parsed for a custom Converter.
+func (d *LogDoc) Constructor() interface{} {
+	args, _ := d.PopValue().([]interface{})
+	name, _ := d.PopValue().(string)
+	return d.convert(name, args...)
 }
@@ -0,0 +1,110 @@
+package logdoc_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+func TestFieldNames(t *testing.T) {
+	cases := []struct{ input, expected string }{
+		{`{ first-name: "a", user id: 1, número: 2, 名前: 3, @type: 4, a/b: 5 }`, `{"@type":4,"a/b":5,"first-name":"a","número":2,"user id":1,"名前":3}`},
+		{`{ "weird key": 1, "a:b": { "x,y": [ 1 ] }, "q\"uote\\": 2, "tab\t\u00e9": 3 }`, `{"a:b":{"x,y":[1]},"q\"uote\\":2,"tab\té":3,"weird key":1}`},
+	}
+	for i, testcase := range cases {
+		doc, err := logdoc.ConvertLogToExtended([]byte(testcase.input))
+		if err != nil {
+			t.Fatalf("case %d: error parsing: %v", i, err)
+		}
+		buf, _ := json.Marshal(doc)
+		if string(buf) != testcase.expected {
+			t.Errorf("case %d: expected '%s'\nbut got '%s'", i, testcase.expected, buf)
+		}
+	}
+}
+
+func TestStringEscapes(t *testing.T) {
+	input := "{ a: \"say \\\"hi\\\"\", b: \"back\\\\slash\", c: \"x\\ny\\tz\\r\", d: \"\\u00e9\\ud83d\\ude00\", e: \"caf\xc3\xa9\", f: \"bad \xff\xfe bytes\", g: Symbol(\"q\\\"\"), h: \"\\xff\\x41\\x4\", i: \"a\\qb \\u12 c:\\\\d\\/e\" }"
+	doc, err := logdoc.ConvertLogToExtended([]byte(input))
+	if err != nil {
+		t.Fatalf("error parsing %s: %v", input, err)
+	}
+	expected := map[string]interface{}{
+		"a": `say "hi"`,
+		"b": `back\slash`,
+		"c": "x\ny\tz\r",
+		"d": "é😀",
+		"e": "café",
+		"f": "bad \xff\xfe bytes",
+		"g": logdoc.Symbol(`q"`),
+		"h": "\xffA\\x4",
+		"i": `a\qb \u12 c:\d/e`,
+	}
+	if !reflect.DeepEqual(doc, expected) {
+		t.Errorf("expected %q\nbut got %q", expected, doc)
+	}
+}
+
+func TestShellTypes(t *testing.T) {
+	cases := []struct{ input, expected string }{
+		{`{ n: NumberInt(42), m: NumberInt("-7") }`, `{"m":-7,"n":42}`},
+		{`{ l: NumberLong("9007199254740993") }`, `{"l":{"$numberLong":"9007199254740993"}}`},
+		{`{ d: NumberDecimal("1.10") }`, `{"d":{"$numberDecimal":"1.10"}}`},
+		{`{ a: ISODate("2015-02-23T03:20:19.670Z"), b: new Date("2015-02-23T05:20:19.670+02:00"), c: ISODate("2015-02-23") }`,
+			`{"a":{"$date":"2015-02-23T03:20:19.670Z"},"b":{"$date":"2015-02-23T03:20:19.670Z"},"c":{"$date":"2015-02-23T00:00:00.000Z"}}`},
+		{`{ d: new Date(-86400000), e: Date(0) }`, `{"d":{"$date":"1969-12-31T00:00:00.000Z"},"e":{"$date":"1970-01-01T00:00:00.000Z"}}`},
+		{`{ u: UUID("0123abcd-0000-4000-8000-0123456789ab") }`, `{"u":{"$binary":"ASOrzQAAQACAAAEjRWeJqw==","$type":"04"}}`},
+		{`{ h: HexData(0, "68656c6c6f") }`, `{"h":{"$binary":"aGVsbG8=","$type":"00"}}`},
+		{`{ $where: function () { if (this.a == "}") { return true; } return this.b > '{'; } }`, `{"$where":{"$code":"function () { if (this.a == \"}\") { return true; } return this.b \u003e '{'; }"}}`},
+		{`{ f: Code("this.a > 1"), g: Code(function(x){ return x; }) }`, `{"f":{"$code":"this.a \u003e 1"},"g":{"$code":"function(x){ return x; }"}}`},
+		{`{ c: CodeWScope( function () { return x; }, { x: 1 }) }`, `{"c":{"$code":"function () { return x; }","$scope":{"x":1}}}`},
+		{`{ r: DBRef("users", ObjectId("54e792daf1845f045f4c000e")), s: DBRef('users', 12, "test") }`, `{"r":{"$id":{"$oid":"54e792daf1845f045f4c000e"},"$ref":"users"},"s":{"$db":"test","$id":12,"$ref":"users"}}`},
+		{`{ p: DBRef('test.users', 54e792daf1845f045f4c000e), q: DBPointer("test.users", ObjectId('54e792daf1845f045f4c000e')) }`, `{"p":{"$dbPointer":{"$id":{"$oid":"54e792daf1845f045f4c000e"},"$ref":"test.users"}},"q":{"$dbPointer":{"$id":{"$oid":"54e792daf1845f045f4c000e"},"$ref":"test.users"}}}`},
+		{`{ s: Symbol("sym") }`, `{"s":{"$symbol":"sym"}}`},
+		{`{ a: 1e+21, b: -2.5E-7, c: .5, d: -.25, e: 5.0, f: 5, g: 9223372036854775808 }`, `{"a":1e+21,"b":-2.5e-7,"c":0.5,"d":-0.25,"e":5.0,"f":5,"g":9.223372036854776e+18}`},
+		{`{ a: NaN, b: Infinity, c: -Infinity, d: nan, e: inf, f: -inf }`, `{"a":{"$numberDouble":"NaN"},"b":{"$numberDouble":"Infinity"},"c":{"$numberDouble":"-Infinity"},"d":{"$numberDouble":"NaN"},"e":{"$numberDouble":"Infinity"},"f":{"$numberDouble":"-Infinity"}}`},
+		{`{ a: 0x1F, b: -0X10, c: [ 1, 2.0 ] }`, `{"a":31,"b":-16,"c":[1,2.0]}`},
+		{`{ a: 0x7FFFFFFFFFFFFFFF, b: 0x8000000000000000, c: -0x10000000000000000 }`, `{"a":9223372036854775807,"b":9.223372036854776e+18,"c":-1.8446744073709552e+19}`},
+	}
+	for i, testcase := range cases {
+		doc, err := logdoc.ConvertLogToExtended([]byte(testcase.input))
+		if err != nil {
+			t.Fatalf("case %d: error parsing: %v", i, err)
+		}
+		buf, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatalf("case %d: error encoding: %v", i, err)
+		}
+		if string(buf) != testcase.expected {
+			t.Errorf("case %d: expected '%s'\nbut got '%s'", i, testcase.expected, buf)
+		}
+	}
+
+	// integers and doubles stay distinct, integral doubles and overflowing
+	// literals as Double
+	doc, _ := logdoc.ConvertLogToExtended([]byte(`{ i: 5, d: 5.0, e: 5e0, f: 5.5, g: 1e400 }`))
+	if _, ok := doc["i"].(int64); !ok {
+		t.Errorf("expected an int64, got %T", doc["i"])
+	}
+	for _, field := range []string{"d", "e", "g"} {
+		if _, ok := doc[field].(logdoc.Double); !ok {
+			t.Errorf("expected a Double for %s, got %T", field, doc[field])
+		}
+	}
+	if _, ok := doc["f"].(float64); !ok {
+		t.Errorf("expected a float64, got %T", doc["f"])
+	}
+	if buf, err := json.Marshal(doc); err != nil || string(buf) != `{"d":5.0,"e":5.0,"f":5.5,"g":{"$numberDouble":"Infinity"},"i":5}` {
+		t.Errorf("unexpected json %s, %v", buf, err)
+	}
+	// malformed values are errors rather than zero values
+	for _, input := range []string{`{ d: ISODate("yesterday") }`, `{ d: new Date("2015-13-45") }`, `{ u: UUID("not-a-uuid") }`, `{ u: UUID("0123abcd") }`, `{ h: HexData(0, "xyz") }`,
+		`{ b: BinData(0) }`, `{ b: BinData(x, "aGVsbG8=") }`, `{ t: Timestamp(1) }`, `{ t: Timestamp(1, x) }`, `{ t: Timestamp 4294967296|1 }`,
+		`{ n: NumberInt(99999999999) }`, `{ n: NumberInt("x") }`, `{ l: NumberLong("9223372036854775808") }`} {
+		if _, err := logdoc.ConvertLogToExtended([]byte(input)); err == nil {
+			t.Errorf("%s: expected an error", input)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package logdoc
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+)
+
+// Kinds of the shell type values handed to a ValueConverter, with the
+// arguments passed for each.
+const (
+	KindDate          = "Date"          // int64 milliseconds since the epoch
+	KindObjectId      = "ObjectId"      // string hex digits
+	KindBinData       = "BinData"       // byte subtype, string base64 data
+	KindTimestamp     = "Timestamp"     // uint32 seconds, uint32 increment
+	KindNumberLong    = "NumberLong"    // int64
+	KindNumberInt     = "NumberInt"     // int32
+	KindNumberDecimal = "NumberDecimal" // string
+	KindRegex         = "Regex"         // string pattern, string options
+	KindMinKey        = "MinKey"        // none
+	KindMaxKey        = "MaxKey"        // none
+	KindUndefined     = "Undefined"     // none
+	KindCode          = "Code"          // string code, map[string]interface{} scope or nil
+	KindDBRef         = "DBRef"         // string collection, interface{} id, string database or ""
+	KindDBPointer     = "DBPointer"     // string namespace, string hex digits
+	KindSymbol        = "Symbol"        // string
+)
+
+// kindArgs holds values of the argument types passed for each kind, nil
+// where any type is accepted.
+var kindArgs = map[string][]interface{}{
+	KindDate:          {int64(0)},
+	KindObjectId:      {""},
+	KindBinData:       {byte(0), ""},
+	KindTimestamp:     {uint32(0), uint32(0)},
+	KindNumberLong:    {int64(0)},
+	KindNumberInt:     {int32(0)},
+	KindNumberDecimal: {""},
+	KindRegex:         {"", ""},
+	KindMinKey:        {},
+	KindMaxKey:        {},
+	KindUndefined:     {},
+	KindCode:          {"", map[string]interface{}(nil)},
+	KindDBRef:         {"", nil, ""},
+	KindDBPointer:     {"", ""},
+	KindSymbol:        {""},
+}
+
+// CheckArgs returns an error unless kind is known and args are of the
+// types passed for it.
+func CheckArgs(kind string, args []interface{}) error {
+	expected, ok := kindArgs[kind]
+	if !ok {
+		return fmt.Errorf("log_doc: unsupported value %s(...)", kind)
+	}
+	if len(args) != len(expected) {
+		return fmt.Errorf("log_doc: %s takes %d arguments, got %d", kind, len(expected), len(args))
+	}
+	for i, e := range expected {
+		if e != nil && reflect.TypeOf(args[i]) != reflect.TypeOf(e) {
+			return fmt.Errorf("log_doc: argument %d of %s is a %T, not a %T", i+1, kind, args[i], e)
+		}
+	}
+	return nil
+}
+
+// ValueConverter converts the shell type values of a document. Any other
+// constructor-style value, such as Point(1, 2), is passed with its name as
+// the kind and its parsed arguments.
+type ValueConverter interface {
+	Convert(kind string, args ...interface{}) (interface{}, error)
+}
+
+// ValueConverterFunc adapts a function to a ValueConverter.
+type ValueConverterFunc func(kind string, args ...interface{}) (interface{}, error)
+
+func (f ValueConverterFunc) Convert(kind string, args ...interface{}) (interface{}, error) {
+	return f(kind, args...)
+}
+
+// DefaultConverter converts values to the mongo_json types, which marshal
+// to extended json, and rejects unknown constructors.
+var DefaultConverter ValueConverter = ValueConverterFunc(defaultConvert)
+
+func defaultConvert(kind string, args ...interface{}) (interface{}, error) {
+	if err := CheckArgs(kind, args); err != nil {
+		return nil, err
+	}
+	switch kind {
+	case KindDate:
+		return mongo_json.Date(args[0].(int64)), nil
+	case KindObjectId:
+		return mongo_json.ObjectId(args[0].(string)), nil
+	case KindBinData:
+		return mongo_json.BinData{Type: args[0].(byte), Base64: args[1].(string)}, nil
+	case KindTimestamp:
+		return mongo_json.Timestamp{Seconds: args[0].(uint32), Increment: args[1].(uint32)}, nil
+	case KindNumberLong:
+		return mongo_json.NumberLong(args[0].(int64)), nil
+	case KindNumberInt:
+		return mongo_json.NumberInt(args[0].(int32)), nil
+	case KindNumberDecimal:
+		return NumberDecimal(args[0].(string)), nil
+	case KindRegex:
+		return mongo_json.RegExp{Pattern: args[0].(string), Options: args[1].(string)}, nil
+	case KindMinKey:
+		return mongo_json.MinKey{}, nil
+	case KindMaxKey:
+		return mongo_json.MaxKey{}, nil
+	case KindUndefined:
+		return mongo_json.Undefined{}, nil
+	case KindCode:
+		return Code{Code: args[0].(string), Scope: args[1].(map[string]interface{})}, nil
+	case KindDBRef:
+		return DBRef{Collection: args[0].(string), Id: args[1], Database: args[2].(string)}, nil
+	case KindDBPointer:
+		return DBPointer{Namespace: args[0].(string), Id: mongo_json.ObjectId(args[1].(string))}, nil
+	case KindSymbol:
+		return Symbol(args[0].(string)), nil
+	}
+	return nil, fmt.Errorf("log_doc: unsupported value %s(...)", kind)
+}
+
+// NativeConverter converts dates to time.Time, ObjectIds to [12]byte,
+// binary data to []byte, regular expressions to *regexp.Regexp and long and
+// int numbers to int64 and int32. Other values are converted by DefaultConverter.
+var NativeConverter ValueConverter = ValueConverterFunc(nativeConvert)
+
+func nativeConvert(kind string, args ...interface{}) (interface{}, error) {
+	if err := CheckArgs(kind, args); err != nil {
+		return nil, err
+	}
+	switch kind {
+	case KindDate:
+		ms := args[0].(int64)
+		return time.Unix(ms/1e3, ms%1e3*int64(time.Millisecond)).UTC(), nil
+	case KindObjectId:
+		var oid [12]byte
+		if n, err := hex.Decode(oid[:], []byte(args[0].(string))); err != nil || n != len(oid) {
+			return nil, fmt.Errorf("log_doc: invalid ObjectId %q", args[0])
+		}
+		return oid, nil
+	case KindBinData:
+		return base64.StdEncoding.DecodeString(args[1].(string))
+	case KindRegex:
+		pattern, options := args[0].(string), args[1].(string)
+		// the options go has an equivalent for
+		var flags strings.Builder
+		for _, o := range options {
+			if strings.ContainsRune("ims", o) {
+				flags.WriteRune(o)
+			}
+		}
+		if flags.Len() > 0 {
+			pattern = "(?" + flags.String() + ")" + pattern
+		}
+		return regexp.Compile(pattern)
+	case KindNumberLong, KindNumberInt:
+		return args[0], nil
+	}
+	return defaultConvert(kind, args...)
+}
+
+// TypeMode selects the types values are converted to.
+type TypeMode int
+
+const (
+	// TypesExtended converts values with DefaultConverter
+	TypesExtended TypeMode = iota
+	// TypesNative converts values with NativeConverter
+	TypesNative
+)
+
+// Converter returns the ValueConverter of the mode, nil for TypesExtended.
+func (m TypeMode) Converter() ValueConverter {
+	if m == TypesNative {
+		return NativeConverter
+	}
+	return nil
+}
+
+// convert converts a value with the Converter, recording the first error.
+func (d *LogDoc) convert(kind string, args ...interface{}) interface{} {
+	c := d.Converter
+	if c == nil {
+		c = DefaultConverter
+	}
+	v, err := c.Convert(kind, args...)
+	if err != nil {
+		d.fail(err)
+	}
+	return v
+}
+
+// fail records err unless an earlier error was recorded.
+func (d *LogDoc) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// Err returns the first error returned by the Converter or met converting
+// a malformed value.
+func (d *LogDoc) Err() error {
+	return d.err
+}
@@ -0,0 +1,141 @@
+package logdoc_test
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+func TestNativeConverter(t *testing.T) {
+	input := `{ d: new Date(1424661619670), o: ObjectId('54e792daf1845f045f4c000e'), b: BinData(0, "aGVsbG8="), r: /^ab/i, l: NumberLong(7), t: Timestamp 1424661619|1 }`
+	doc, err := logdoc.ConvertLogToExtendedOptions([]byte(input), logdoc.Options{Converter: logdoc.NativeConverter})
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+	if d, ok := doc["d"].(time.Time); !ok || !d.Equal(time.Date(2015, 2, 23, 3, 20, 19, 670e6, time.UTC)) {
+		t.Errorf("unexpected date %#v", doc["d"])
+	}
+	oid := [12]byte{0x54, 0xe7, 0x92, 0xda, 0xf1, 0x84, 0x5f, 0x04, 0x5f, 0x4c, 0x00, 0x0e}
+	if doc["o"] != oid {
+		t.Errorf("unexpected ObjectId %#v", doc["o"])
+	}
+	if b, ok := doc["b"].([]byte); !ok || string(b) != "hello" {
+		t.Errorf("unexpected binary data %#v", doc["b"])
+	}
+	if r, ok := doc["r"].(*regexp.Regexp); !ok || !r.MatchString("ABC") {
+		t.Errorf("unexpected regex %#v", doc["r"])
+	}
+	if doc["l"] != int64(7) {
+		t.Errorf("unexpected long %#v", doc["l"])
+	}
+	// values without a native equivalent are converted as usual
+	if reflect.TypeOf(doc["t"]).Name() != "Timestamp" {
+		t.Errorf("unexpected timestamp %#v", doc["t"])
+	}
+}
+
+type point struct{ X, Y int64 }
+
+func TestCustomConverter(t *testing.T) {
+	var kinds []string
+	converter := logdoc.ValueConverterFunc(func(kind string, args ...interface{}) (interface{}, error) {
+		kinds = append(kinds, kind)
+		switch kind {
+		case "Point":
+			if len(args) != 2 {
+				return nil, errors.New("Point takes two arguments")
+			}
+			x, _ := args[0].(int64)
+			y, _ := args[1].(int64)
+			return point{x, y}, nil
+		case logdoc.KindObjectId:
+			return args[0], nil
+		}
+		return logdoc.DefaultConverter.Convert(kind, args...)
+	})
+	opts := logdoc.Options{Converter: converter}
+
+	doc, err := logdoc.ConvertLogToExtendedOptions([]byte(`{ loc: Point(1, -2), _id: ObjectId('54e792daf1845f045f4c000e'), n: [ Point( 3,4 ) ] }`), opts)
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+	if doc["loc"] != (point{1, -2}) {
+		t.Errorf("unexpected point %#v", doc["loc"])
+	}
+	if doc["_id"] != "54e792daf1845f045f4c000e" {
+		t.Errorf("unexpected ObjectId %#v", doc["_id"])
+	}
+	if !reflect.DeepEqual(doc["n"], []interface{}{point{3, 4}}) {
+		t.Errorf("unexpected points %#v", doc["n"])
+	}
+	if !reflect.DeepEqual(kinds, []string{"Point", logdoc.KindObjectId, "Point"}) {
+		t.Errorf("unexpected kinds %v", kinds)
+	}
+
+	// converter errors fail the parse
+	if _, err := logdoc.ConvertLogToExtendedOptions([]byte(`{ loc: Point(1) }`), opts); err == nil || err.Error() != "Point takes two arguments" {
+		t.Errorf("expected the converter error, got %v", err)
+	}
+	// unknown constructors are rejected by the default converter
+	if _, err := logdoc.ConvertLogToExtendedOptions([]byte(`{ loc: Polygon(1) }`), opts); err == nil {
+		t.Error("expected an error for an unknown constructor")
+	}
+	// and aren't parsed without a converter
+	if _, err := logdoc.ConvertLogToExtended([]byte(`{ loc: Point(1, 2) }`)); err == nil {
+		t.Error("expected a parse error without a converter")
+	}
+}
+
+func TestConverterArgs(t *testing.T) {
+	// shell type names are only constructed by their own rules
+	opts := logdoc.Options{Converter: logdoc.NativeConverter}
+	for _, input := range []string{`{ a: ObjectId() }`, `{ a: ObjectId(1) }`, `{ a: Regex("x") }`, `{ a: Date("x", 1) }`, `{ a: Symbol(1) }`} {
+		if _, err := logdoc.ConvertLogToExtendedOptions([]byte(input), opts); err == nil {
+			t.Errorf("%s: expected an error", input)
+		}
+	}
+
+	// arguments of the wrong number or type are errors
+	cases := []struct {
+		kind string
+		args []interface{}
+	}{
+		{logdoc.KindObjectId, nil},
+		{logdoc.KindObjectId, []interface{}{int64(1)}},
+		{logdoc.KindRegex, []interface{}{"x"}},
+		{logdoc.KindDate, []interface{}{"x", int64(1)}},
+		{logdoc.KindCode, []interface{}{"x", 1}},
+		{"Point", []interface{}{int64(1)}},
+	}
+	for _, converter := range []logdoc.ValueConverter{logdoc.DefaultConverter, logdoc.NativeConverter} {
+		for _, c := range cases {
+			if _, err := converter.Convert(c.kind, c.args...); err == nil {
+				t.Errorf("%s%v: expected an error", c.kind, c.args)
+			}
+		}
+	}
+	if _, err := logdoc.DefaultConverter.Convert(logdoc.KindDBRef, "users", int64(1), ""); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestTypeModeGrammar(t *testing.T) {
+	// choosing a type mode doesn't change what the grammar accepts
+	opts := logdoc.Options{Types: logdoc.TypesNative}
+	for _, input := range []string{`{ a: ObjectId() }`, `{ a: Point(1, 2) }`} {
+		if _, err := logdoc.ConvertLogToExtendedOptions([]byte(input), opts); err == nil {
+			t.Errorf("%s: expected a parse error", input)
+		}
+	}
+	doc, err := logdoc.ConvertLogToExtendedOptions([]byte(`{ a: ObjectId('54e792daf1845f045f4c000e') }`), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc["a"].([12]byte); !ok {
+		t.Errorf("expected a [12]byte, got %#v", doc["a"])
+	}
+}
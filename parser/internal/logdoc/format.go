@@ -0,0 +1,266 @@
+package logdoc
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+)
+
+// Format renders v in the document syntax of MongoDB log lines, the inverse
+// of ConvertLogToExtended. Document fields are written in sorted order.
+func Format(v interface{}) ([]byte, error) {
+	return FormatIndent(v, "")
+}
+
+// FormatIndent is like Format but writes each document field and list
+// element on its own line, indented by one more indent than its container.
+func FormatIndent(v interface{}, indent string) ([]byte, error) {
+	f := &formatter{indent: indent}
+	if err := f.value(v, 0); err != nil {
+		return nil, err
+	}
+	return f.buf.Bytes(), nil
+}
+
+type formatter struct {
+	buf    bytes.Buffer
+	indent string
+}
+
+// open starts a nested element of a container at depth.
+func (f *formatter) open(i, depth int) {
+	if i > 0 {
+		f.buf.WriteByte(',')
+	}
+	if f.indent == "" {
+		f.buf.WriteByte(' ')
+		return
+	}
+	f.buf.WriteByte('\n')
+	f.buf.WriteString(strings.Repeat(f.indent, depth+1))
+}
+
+// close ends a container at depth.
+func (f *formatter) close(c byte, depth int) {
+	if f.indent == "" {
+		f.buf.WriteByte(' ')
+	} else {
+		f.buf.WriteByte('\n')
+		f.buf.WriteString(strings.Repeat(f.indent, depth))
+	}
+	f.buf.WriteByte(c)
+}
+
+func (f *formatter) value(v interface{}, depth int) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			f.buf.WriteString("{}")
+			return nil
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		f.buf.WriteByte('{')
+		for i, k := range keys {
+			f.open(i, depth)
+			f.buf.WriteString(fieldName(k))
+			f.buf.WriteString(": ")
+			if err := f.value(v[k], depth+1); err != nil {
+				return err
+			}
+		}
+		f.close('}', depth)
+	case []interface{}:
+		if len(v) == 0 {
+			f.buf.WriteString("[]")
+			return nil
+		}
+		f.buf.WriteByte('[')
+		for i, elem := range v {
+			f.open(i, depth)
+			if err := f.value(elem, depth+1); err != nil {
+				return err
+			}
+		}
+		f.close(']', depth)
+	case nil:
+		f.buf.WriteString("null")
+	case bool:
+		f.buf.WriteString(strconv.FormatBool(v))
+	case string:
+		f.buf.WriteString(quote(v))
+	case int:
+		f.buf.WriteString(strconv.Itoa(v))
+	case int32:
+		f.buf.WriteString(strconv.FormatInt(int64(v), 10))
+	case int64:
+		f.buf.WriteString(strconv.FormatInt(v, 10))
+	case float32:
+		f.float(float64(v))
+	case float64:
+		f.float(v)
+	case Double:
+		f.float(float64(v))
+	case time.Time:
+		fmt.Fprintf(&f.buf, "new Date(%d)", v.UnixNano()/int64(time.Millisecond))
+	case mongo_json.Date:
+		fmt.Fprintf(&f.buf, "new Date(%d)", int64(v))
+	case mongo_json.ObjectId:
+		fmt.Fprintf(&f.buf, "ObjectId('%s')", string(v))
+	case mongo_json.BinData:
+		fmt.Fprintf(&f.buf, `BinData(%d,"%s")`, v.Type, v.Base64)
+	case mongo_json.Timestamp:
+		fmt.Fprintf(&f.buf, "Timestamp(%d, %d)", v.Seconds, v.Increment)
+	case mongo_json.NumberLong:
+		fmt.Fprintf(&f.buf, "NumberLong(%d)", int64(v))
+	case mongo_json.NumberInt:
+		fmt.Fprintf(&f.buf, "NumberInt(%d)", int32(v))
+	case NumberDecimal:
+		fmt.Fprintf(&f.buf, "NumberDecimal(%s)", quote(string(v)))
+	case Code:
+		switch {
+		case v.Scope != nil:
+			fmt.Fprintf(&f.buf, "CodeWScope(%s, ", quote(v.Code))
+			if err := f.value(v.Scope, depth); err != nil {
+				return err
+			}
+			f.buf.WriteByte(')')
+		case isFunction(v.Code):
+			f.buf.WriteString(v.Code)
+		default:
+			fmt.Fprintf(&f.buf, "Code(%s)", quote(v.Code))
+		}
+	case DBRef:
+		fmt.Fprintf(&f.buf, "DBRef(%s, ", quote(v.Collection))
+		if err := f.value(v.Id, depth); err != nil {
+			return err
+		}
+		if v.Database != "" {
+			fmt.Fprintf(&f.buf, ", %s", quote(v.Database))
+		}
+		f.buf.WriteByte(')')
+	case DBPointer:
+		fmt.Fprintf(&f.buf, "DBPointer(%s, ObjectId('%s'))", quote(v.Namespace), string(v.Id))
+	case Symbol:
+		fmt.Fprintf(&f.buf, "Symbol(%s)", quote(string(v)))
+	case mongo_json.NumberFloat:
+		f.float(float64(v))
+	case mongo_json.RegExp:
+		fmt.Fprintf(&f.buf, "/%s/%s", v.Pattern, v.Options)
+	case mongo_json.MinKey:
+		f.buf.WriteString("MinKey")
+	case mongo_json.MaxKey:
+		f.buf.WriteString("MaxKey")
+	case mongo_json.Undefined:
+		f.buf.WriteString("undefined")
+	default:
+		return fmt.Errorf("log_doc: cannot format value of type %T", v)
+	}
+	return nil
+}
+
+// float writes a double so that it reads back as one, with a fractional part.
+func (f *formatter) float(v float64) {
+	switch {
+	case math.IsNaN(v):
+		f.buf.WriteString("nan")
+	case math.IsInf(v, 1):
+		f.buf.WriteString("inf")
+	case math.IsInf(v, -1):
+		f.buf.WriteString("-inf")
+	default:
+		// exponents for very large and small magnitudes, as javascript prints them
+		format := byte('f')
+		if abs := math.Abs(v); abs >= 1e21 || (abs != 0 && abs < 1e-6) {
+			format = 'e'
+		}
+		s := strconv.FormatFloat(v, format, -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		f.buf.WriteString(s)
+	}
+}
+
+// isFunction reports whether code is a complete function, which is written
+// unquoted: the function keyword, its parameters and a balanced body.
+func isFunction(code string) bool {
+	body := strings.IndexByte(code, '{')
+	if !strings.HasPrefix(code, "function") || body < 0 || !strings.Contains(code[:body], "(") {
+		return false
+	}
+	return jsBlock(code[body:]) == len(code)-body
+}
+
+// jsBlock returns the length of the braced block s starts with, skipping
+// quoted strings, or -1 if the block isn't closed.
+func jsBlock(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return i + 1
+			}
+		case '"', '\'':
+			for i++; i < len(s) && s[i] != c; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// fieldName returns k as mongod prints it, quoting the keys that wouldn't
+// parse back unquoted.
+func fieldName(k string) string {
+	if k == "" || k[0] == ' ' || k[len(k)-1] == ' ' || strings.ContainsAny(k, ":,{}[]\"\t\r\n") {
+		return quote(k)
+	}
+	return k
+}
+
+// quote returns s as a string literal, the inverse of LogDoc.Unescape.
+// Invalid utf-8 bytes are written unchanged.
+func quote(s string) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '"' || r == '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case r < ' ':
+			fmt.Fprintf(&buf, `\u%04x`, r)
+		default:
+			buf.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
@@ -0,0 +1,179 @@
+package logdoc_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+func TestFormat(t *testing.T) {
+	cases := []struct{ input, expected string }{
+		{`{ foo: [ 42 ] }`, `{ foo: [ 42 ] }`},
+		{`{b:{},a:[],c:null}`, `{ a: [], b: {}, c: null }`},
+		{`{ _updated_at: { $lte: new Date(1412941647719) } }`, `{ _updated_at: { $lte: new Date(1412941647719) } }`},
+		{`{ _id: ObjectId("54e792daf1845f045f4c000e"), data: BinData(0,"aGVsbG8K") }`, `{ _id: ObjectId('54e792daf1845f045f4c000e'), data: BinData(0,"aGVsbG8K") }`},
+		{`{ t: Timestamp 1420000000|1, u: undefined }`, `{ t: Timestamp(1420000000, 1), u: undefined }`},
+		{`{ some_text: /ese/i, min: MinKey, max: MaxKey }`, `{ max: MaxKey, min: MinKey, some_text: /ese/i }`},
+		{`{ n: NumberLong(-9223372036854775808), f: 2.0, g: -0.25, ok: true }`, `{ f: 2.0, g: -0.25, n: NumberLong(-9223372036854775808), ok: true }`},
+		{`{ "a b": 1, "c:d": 2, e-f: 3, "": 4, " g": 5 }`, `{ "": 4, " g": 5, a b: 1, "c:d": 2, e-f: 3 }`},
+		{`{ a: 1e+21, b: -2.5e-7, c: .5, d: NaN, e: -Infinity }`, `{ a: 1e+21, b: -2.5e-07, c: 0.5, d: nan, e: -inf }`},
+		{`{ i: NumberInt(7), d: NumberDecimal("1.10"), t: ISODate("1969-12-31T23:59:59.999Z") }`, `{ d: NumberDecimal("1.10"), i: NumberInt(7), t: new Date(-1) }`},
+		{`{ f: function (x) { return "}"; }, g: Code("functionality"), h: Code("function () { } x") }`, `{ f: function (x) { return "}"; }, g: Code("functionality"), h: Code("function () { } x") }`},
+	}
+	for i, testcase := range cases {
+		doc, err := logdoc.ConvertLogToExtended([]byte(testcase.input))
+		if err != nil {
+			t.Fatalf("case %d: error parsing: %v", i, err)
+		}
+		buf, err := logdoc.Format(doc)
+		if err != nil {
+			t.Fatalf("case %d: error formatting: %v", i, err)
+		}
+		if string(buf) != testcase.expected {
+			t.Errorf("case %d: expected '%s'\nbut got '%s'", i, testcase.expected, buf)
+		}
+	}
+
+	buf, _ := logdoc.FormatIndent(map[string]interface{}{"a": int64(1), "b": []interface{}{"x", map[string]interface{}{}}}, "  ")
+	expected := "{\n  a: 1,\n  b: [\n    \"x\",\n    {}\n  ]\n}"
+	if string(buf) != expected {
+		t.Errorf("expected\n%s\nbut got\n%s", expected, buf)
+	}
+
+	if _, err := logdoc.Format(map[string]interface{}{"c": make(chan int)}); err == nil {
+		t.Error("expected an error formatting an unsupported type")
+	}
+}
+
+// randomDoc generates documents holding the values the parser produces
+type randomDoc struct {
+	r *rand.Rand
+}
+
+func (g randomDoc) word(alphabet string, n int) string {
+	runes := []rune(alphabet)
+	w := make([]rune, 1+g.r.Intn(n))
+	for i := range w {
+		w[i] = runes[g.r.Intn(len(runes))]
+	}
+	return string(w)
+}
+
+func (g randomDoc) key() string {
+	if g.r.Intn(4) == 0 {
+		return g.word("abc -:,{}[]\t\"\\éü名", 8)
+	}
+	return g.word("abcxyzABC_$019", 8)
+}
+
+func (g randomDoc) doc(depth int) map[string]interface{} {
+	doc := map[string]interface{}{}
+	for i := g.r.Intn(5); i > 0; i-- {
+		doc[g.key()] = g.value(depth + 1)
+	}
+	return doc
+}
+
+func (g randomDoc) value(depth int) interface{} {
+	kind := g.r.Intn(25)
+	if depth > 3 && kind < 2 {
+		kind += 2
+	}
+	switch kind {
+	case 0:
+		return g.doc(depth)
+	case 1:
+		list := []interface{}{}
+		for i := g.r.Intn(4); i > 0; i-- {
+			list = append(list, g.value(depth+1))
+		}
+		return list
+	case 2:
+		return g.r.Int63() - g.r.Int63()
+	case 3:
+		f := (g.r.Float64() - 0.5) * math.Pow(10, float64(g.r.Intn(60)-30))
+		if f == math.Trunc(f) {
+			// integral doubles parse as Double
+			return logdoc.Double(f)
+		}
+		return f
+	case 4:
+		return g.r.Intn(2) == 0
+	case 5:
+		return nil
+	case 6:
+		if g.r.Intn(8) == 0 {
+			// invalid utf-8 is passed through unchanged
+			return g.word("abc", 4) + "\xff\xfe" + g.word("abc", 4)
+		}
+		return g.word("abc xyz ABC:{}[],.-_019\"\\\n\r\t\x01é名😀", 20)
+	case 7:
+		oid := make([]byte, 24)
+		for i := range oid {
+			oid[i] = "0123456789abcdef"[g.r.Intn(16)]
+		}
+		return mongo_json.ObjectId(oid)
+	case 8:
+		return mongo_json.Date(g.r.Int63n(8e12) - 4e12)
+	case 9:
+		return mongo_json.BinData{Type: byte(g.r.Intn(256)), Base64: g.word("ABCxyz019+/", 12) + "=="}
+	case 10:
+		return mongo_json.Timestamp{Seconds: g.r.Uint32(), Increment: g.r.Uint32()}
+	case 11:
+		return mongo_json.NumberLong(g.r.Int63() - g.r.Int63())
+	case 12:
+		return mongo_json.RegExp{Pattern: g.word(`abc^$.*+?()\[]`, 10), Options: g.word("gims", 3)}
+	case 13:
+		return mongo_json.MinKey{}
+	case 14:
+		return mongo_json.MaxKey{}
+	case 15:
+		return mongo_json.Undefined{}
+	case 16:
+		return mongo_json.NumberInt(g.r.Int31() - g.r.Int31())
+	case 17:
+		return logdoc.NumberDecimal(g.word("0123456789", 6) + "." + g.word("0123456789", 4))
+	case 18:
+		return logdoc.Code{Code: "function (x) { if (x) { return '" + g.word("abc{}", 5) + "'; } }"}
+	case 19:
+		// code starting like a function is only written raw if it is one
+		return logdoc.Code{Code: []string{"", "function", "function ("}[g.r.Intn(3)] + g.word("abc xyz(){};.=", 20)}
+	case 20:
+		return logdoc.Code{Code: g.word("abc xyz(){};.=", 20), Scope: g.doc(depth)}
+	case 21:
+		return logdoc.DBRef{Collection: g.word("abcxyz", 8), Id: g.value(depth + 1)}
+	case 22:
+		return logdoc.DBRef{Collection: g.word("abcxyz", 8), Id: int64(g.r.Intn(100)), Database: g.word("abcxyz", 8)}
+	case 23:
+		oid := make([]byte, 24)
+		for i := range oid {
+			oid[i] = "0123456789abcdef"[g.r.Intn(16)]
+		}
+		return logdoc.DBPointer{Namespace: g.word("abcxyz.", 12), Id: mongo_json.ObjectId(oid)}
+	case 24:
+		return logdoc.Symbol(g.word("abc xyz", 8))
+	}
+	return int64(g.r.Intn(100))
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	g := randomDoc{rand.New(rand.NewSource(1))}
+	for i := 0; i < 2000; i++ {
+		doc := g.doc(0)
+		buf, err := logdoc.Format(doc)
+		if err != nil {
+			t.Fatalf("error formatting %#v: %v", doc, err)
+		}
+		parsed, err := logdoc.ConvertLogToExtended(buf)
+		if err != nil {
+			t.Fatalf("error parsing '%s': %v", buf, err)
+		}
+		if !reflect.DeepEqual(parsed, doc) {
+			t.Fatalf("round trip of '%s' changed\n%#v\nto\n%#v", buf, doc, parsed)
+		}
+	}
+}
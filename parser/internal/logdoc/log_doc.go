@@ -2,17 +2,50 @@
 
 package logdoc
 
-import "fmt"
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Options configure the conversion of a document
+type Options struct {
+	// Converter converts shell type values, overriding Types if set
+	Converter ValueConverter
+	// Types selects the converter used when Converter is nil
+	Types TypeMode
+	// MaxDepth rejects documents with maps and lists nested deeper than
+	// MaxDepth levels, 0 for no limit
+	MaxDepth int
+}
+
+// Configure applies opts to the parser state d.
+func (d *LogDoc) Configure(opts Options) {
+	d.Converter = opts.Converter
+	d.Constructors = opts.Converter != nil
+	if d.Converter == nil {
+		d.Converter = opts.Types.Converter()
+	}
+	d.MaxDepth = opts.MaxDepth
+}
 
 // ConvertLogToExtended converts MongoDB log line formatted documents to an extended JSON representation
 func ConvertLogToExtended(input []byte) (map[string]interface{}, error) {
+	return ConvertLogToExtendedOptions(input, Options{})
+}
+
+// ConvertLogToExtendedOptions is like ConvertLogToExtended but configured by opts
+func ConvertLogToExtendedOptions(input []byte, opts Options) (map[string]interface{}, error) {
 	p := &LogDocParser{Buffer: string(input)}
 	p.Init()
 	p.LogDoc.Init()
+	p.Configure(opts)
 	if err := p.Parse(); err != nil {
 		return nil, err
 	}
 	p.Execute()
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
 
 	if len(p.Values) == 0 {
 		return nil, fmt.Errorf("log_doc: no values present after parsing")
@@ -23,11 +56,54 @@ func ConvertLogToExtended(input []byte) (map[string]interface{}, error) {
 	return nil, fmt.Errorf("log_doc: got unexpected type %T", p.Values[0])
 }
 
+// text returns the input between the rune offsets begin and end. Slicing
+// the input rather than the runes keeps invalid utf-8 bytes intact.
+func (p *LogDocParser) text(buffer string, begin, end int) string {
+	if p.offsets == nil {
+		p.offsets = ByteOffsets(buffer)
+	}
+	if len(p.offsets) == 0 {
+		return buffer[begin:end]
+	}
+	return buffer[p.offsets[begin]:p.offsets[end]]
+}
+
+// ByteOffsets maps the rune offsets used as positions by the parsers to
+// byte offsets into s. Like the conversion to []rune, each invalid utf-8
+// byte counts as one rune. The offsets are the same for ascii input, for
+// which an empty slice is returned.
+func ByteOffsets(s string) []int {
+	ascii := true
+	for i := 0; i < len(s) && ascii; i++ {
+		ascii = s[i] < utf8.RuneSelf
+	}
+	if ascii {
+		return []int{}
+	}
+	offsets := make([]int, 0, len(s)+2)
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	// the end of input and the end symbol appended by the parser
+	return append(offsets, len(s), len(s))
+}
+
 type LogDoc struct {
 	Maps   []int
 	Lists  []int
 	Fields []string
 	Values []interface{}
+
+	// Converter converts shell type values, DefaultConverter if nil
+	Converter ValueConverter
+	// Constructors enables parsing of unknown constructor-style values,
+	// which are handed to a custom Converter
+	Constructors bool
+	// MaxDepth limits the nesting of maps and lists, 0 for no limit
+	MaxDepth int
+
+	offsets []int
+	err     error
 }
 
 func (d *LogDoc) Init() {
@@ -38,11 +114,20 @@ func (d *LogDoc) Init() {
 func (d *LogDoc) PushMap() {
 	d.Values = append(d.Values, make(map[string]interface{}))
 	d.Maps = append(d.Maps, len(d.Values)-1)
+	d.checkDepth()
 }
 
 func (d *LogDoc) PushList() {
 	d.Values = append(d.Values, make([]interface{}, 0))
 	d.Lists = append(d.Lists, len(d.Values)-1)
+	d.checkDepth()
+}
+
+// checkDepth records an error once the open maps and lists exceed MaxDepth.
+func (d *LogDoc) checkDepth() {
+	if d.MaxDepth > 0 && len(d.Maps)+len(d.Lists) > d.MaxDepth {
+		d.fail(fmt.Errorf("log_doc: document nested deeper than %d levels", d.MaxDepth))
+	}
 }
 
 func (d *LogDoc) PushValue(value interface{}) {
@@ -30,21 +30,45 @@ const (
 	ruleTrue
 	ruleFalse
 	ruleDate
+	ruledateMillis
+	ruledateString
 	ruleObjectID
 	ruleBinData
+	ruleUUID
+	ruleHexData
 	ruleRegex
 	ruleTimestampVal
 	ruletimestampParen
 	ruletimestampPipe
 	ruleNumberLong
+	ruleNumberInt
+	ruleNumberDecimal
+	ruleJavaScript
+	ruleCode
+	ruleCodeWScope
+	ruleDBRef
+	ruleDBPointer
+	ruleSymbol
+	ruleConstructor
+	rulebuiltinName
 	ruleMinKey
 	ruleMaxKey
 	ruleUndefined
 	rulehexChar
+	rulenumberSpecial
+	rulenumberHex
+	rulenumberDecimal
+	rulecodeArg
+	rulejsFunction
+	rulejsBlock
+	rulejsString
+	rulerefName
+	rulerefId
 	ruleregexChar
 	ruleregexBody
 	rulestringChar
-	rulefieldChar
+	rulefieldName
+	rulefieldNameChar
 	ruleS
 	ruleAction0
 	ruleAction1
@@ -69,6 +93,26 @@ const (
 	ruleAction19
 	ruleAction20
 	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
+	ruleAction38
+	ruleAction39
+	ruleAction40
+	ruleAction41
 
 	rulePre_
 	rule_In_
@@ -93,21 +137,45 @@ var rul3s = [...]string{
 	"True",
 	"False",
 	"Date",
+	"dateMillis",
+	"dateString",
 	"ObjectID",
 	"BinData",
+	"UUID",
+	"HexData",
 	"Regex",
 	"TimestampVal",
 	"timestampParen",
 	"timestampPipe",
 	"NumberLong",
+	"NumberInt",
+	"NumberDecimal",
+	"JavaScript",
+	"Code",
+	"CodeWScope",
+	"DBRef",
+	"DBPointer",
+	"Symbol",
+	"Constructor",
+	"builtinName",
 	"MinKey",
 	"MaxKey",
 	"Undefined",
 	"hexChar",
+	"numberSpecial",
+	"numberHex",
+	"numberDecimal",
+	"codeArg",
+	"jsFunction",
+	"jsBlock",
+	"jsString",
+	"refName",
+	"refId",
 	"regexChar",
 	"regexBody",
 	"stringChar",
-	"fieldChar",
+	"fieldName",
+	"fieldNameChar",
 	"S",
 	"Action0",
 	"Action1",
@@ -132,6 +200,26 @@ var rul3s = [...]string{
 	"Action19",
 	"Action20",
 	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
+	"Action38",
+	"Action39",
+	"Action40",
+	"Action41",
 
 	"Pre_",
 	"_In_",
@@ -700,7 +788,7 @@ type LogDocParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [56]func() bool
+	rules  [100]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	tokenTree
@@ -788,37 +876,79 @@ func (p *LogDocParser) Execute() {
 		case ruleAction5:
 			p.SetListValue()
 		case ruleAction6:
-			p.PushField(buffer[begin:end])
+			p.PushField(p.Unescape(p.text(buffer, begin, end)))
 		case ruleAction7:
-			p.PushValue(p.Numeric(buffer[begin:end]))
+			p.PushField(p.text(buffer, begin, end))
 		case ruleAction8:
-			p.PushValue(buffer[begin:end])
+			p.PushValue(p.Numeric(p.text(buffer, begin, end)))
 		case ruleAction9:
-			p.PushValue(nil)
+			p.PushValue(p.Unescape(p.text(buffer, begin, end)))
 		case ruleAction10:
-			p.PushValue(true)
+			p.PushValue(nil)
 		case ruleAction11:
-			p.PushValue(false)
+			p.PushValue(true)
 		case ruleAction12:
-			p.PushValue(p.Date(buffer[begin:end]))
+			p.PushValue(false)
 		case ruleAction13:
-			p.PushValue(p.ObjectId(buffer[begin:end]))
+			p.PushValue(p.Date(p.text(buffer, begin, end)))
 		case ruleAction14:
-			p.PushValue(p.Bindata(buffer[begin:end]))
+			p.PushValue(p.ISODate(p.text(buffer, begin, end)))
 		case ruleAction15:
-			p.PushValue(p.Regex(buffer[begin:end]))
+			p.PushValue(p.ObjectId(p.text(buffer, begin, end)))
 		case ruleAction16:
-			p.PushValue(p.Timestamp(buffer[begin:end]))
+			p.PushValue(p.Bindata(p.text(buffer, begin, end)))
 		case ruleAction17:
-			p.PushValue(p.Timestamp(buffer[begin:end]))
+			p.PushValue(p.Uuid(p.text(buffer, begin, end)))
 		case ruleAction18:
-			p.PushValue(p.Numberlong(buffer[begin:end]))
+			p.PushValue(p.Hexdata(p.text(buffer, begin, end)))
 		case ruleAction19:
-			p.PushValue(p.Minkey())
+			p.PushValue(p.Regex(p.text(buffer, begin, end)))
 		case ruleAction20:
-			p.PushValue(p.Maxkey())
+			p.PushValue(p.Timestamp(p.text(buffer, begin, end)))
 		case ruleAction21:
+			p.PushValue(p.Timestamp(p.text(buffer, begin, end)))
+		case ruleAction22:
+			p.PushValue(p.Numberlong(p.text(buffer, begin, end)))
+		case ruleAction23:
+			p.PushValue(p.Numberint(p.text(buffer, begin, end)))
+		case ruleAction24:
+			p.PushValue(p.Numberdecimal(p.text(buffer, begin, end)))
+		case ruleAction25:
+			p.PushValue(p.Javascript(p.text(buffer, begin, end)))
+		case ruleAction26:
+			p.PushValue(p.Javascript(p.PopValue().(string)))
+		case ruleAction27:
+			p.PushValue(p.CodeWScope())
+		case ruleAction28:
+			p.PushValue(p.DBRef(true))
+		case ruleAction29:
+			p.PushValue(p.DBRef(false))
+		case ruleAction30:
+			p.PushValue(p.DBPointer())
+		case ruleAction31:
+			p.PushValue(p.Symbol(p.PopValue().(string)))
+		case ruleAction32:
+			p.PushValue(p.text(buffer, begin, end))
+			p.PushList()
+		case ruleAction33:
+			p.SetListValue()
+		case ruleAction34:
+			p.SetListValue()
+		case ruleAction35:
+			p.PopList()
+			p.PushValue(p.Constructor())
+		case ruleAction36:
+			p.PushValue(p.Minkey())
+		case ruleAction37:
+			p.PushValue(p.Maxkey())
+		case ruleAction38:
 			p.PushValue(p.Undefined())
+		case ruleAction39:
+			p.PushValue(p.text(buffer, begin, end))
+		case ruleAction40:
+			p.PushValue(p.text(buffer, begin, end))
+		case ruleAction41:
+			p.PushValue(p.text(buffer, begin, end))
 
 		}
 	}
@@ -991,171 +1121,136 @@ func (p *LogDocParser) Init() {
 					position17 := position
 					depth++
 					{
-						position18 := position
-						depth++
+						position18, tokenIndex18, depth18 := position, tokenIndex, depth
+						if buffer[position] != rune('"') {
+							goto l19
+						}
+						position++
 						{
-							position21 := position
+							position20 := position
 							depth++
+						l21:
 							{
-								switch buffer[position] {
-								case '$', '*', '.', '_':
-									{
-										switch buffer[position] {
-										case '*':
-											if buffer[position] != rune('*') {
-												goto l13
-											}
-											position++
-											break
-										case '.':
-											if buffer[position] != rune('.') {
-												goto l13
-											}
-											position++
-											break
-										case '$':
-											if buffer[position] != rune('$') {
-												goto l13
-											}
-											position++
-											break
-										default:
-											if buffer[position] != rune('_') {
-												goto l13
-											}
-											position++
-											break
-										}
-									}
-
-									break
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l13
-									}
-									position++
-									break
-								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l13
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l13
-									}
-									position++
-									break
+								position22, tokenIndex22, depth22 := position, tokenIndex, depth
+								if !_rules[rulestringChar]() {
+									goto l22
 								}
+								goto l21
+							l22:
+								position, tokenIndex, depth = position22, tokenIndex22, depth22
 							}
-
 							depth--
-							add(rulefieldChar, position21)
+							add(rulePegText, position20)
+						}
+						if buffer[position] != rune('"') {
+							goto l19
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l19
 						}
+						position++
+						{
+							add(ruleAction6, position)
+						}
+						goto l18
 					l19:
+						position, tokenIndex, depth = position18, tokenIndex18, depth18
 						{
-							position20, tokenIndex20, depth20 := position, tokenIndex, depth
+							position24 := position
+							depth++
 							{
-								position24 := position
+								position25 := position
 								depth++
+								if !_rules[rulefieldNameChar]() {
+									goto l13
+								}
+							l26:
 								{
-									switch buffer[position] {
-									case '$', '*', '.', '_':
-										{
-											switch buffer[position] {
-											case '*':
-												if buffer[position] != rune('*') {
-													goto l20
-												}
-												position++
-												break
-											case '.':
-												if buffer[position] != rune('.') {
-													goto l20
-												}
-												position++
-												break
-											case '$':
-												if buffer[position] != rune('$') {
-													goto l20
-												}
-												position++
-												break
-											default:
-												if buffer[position] != rune('_') {
-													goto l20
-												}
-												position++
-												break
-											}
-										}
-
-										break
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l20
-										}
-										position++
-										break
-									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-										if c := buffer[position]; c < rune('A') || c > rune('Z') {
-											goto l20
+									position27, tokenIndex27, depth27 := position, tokenIndex, depth
+									if !_rules[rulefieldNameChar]() {
+										goto l27
+									}
+									goto l26
+								l27:
+									position, tokenIndex, depth = position27, tokenIndex27, depth27
+								}
+							l28:
+								{
+									position29, tokenIndex29, depth29 := position, tokenIndex, depth
+									if buffer[position] != rune(' ') {
+										goto l29
+									}
+									position++
+								l30:
+									{
+										position31, tokenIndex31, depth31 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l31
 										}
 										position++
-										break
-									default:
-										if c := buffer[position]; c < rune('a') || c > rune('z') {
-											goto l20
+										goto l30
+									l31:
+										position, tokenIndex, depth = position31, tokenIndex31, depth31
+									}
+									if !_rules[rulefieldNameChar]() {
+										goto l29
+									}
+								l32:
+									{
+										position33, tokenIndex33, depth33 := position, tokenIndex, depth
+										if !_rules[rulefieldNameChar]() {
+											goto l33
 										}
-										position++
-										break
+										goto l32
+									l33:
+										position, tokenIndex, depth = position33, tokenIndex33, depth33
 									}
+									goto l28
+								l29:
+									position, tokenIndex, depth = position29, tokenIndex29, depth29
 								}
-
 								depth--
-								add(rulefieldChar, position24)
+								add(rulefieldName, position25)
 							}
-							goto l19
-						l20:
-							position, tokenIndex, depth = position20, tokenIndex20, depth20
+							depth--
+							add(rulePegText, position24)
+						}
+						if buffer[position] != rune(':') {
+							goto l13
+						}
+						position++
+						{
+							add(ruleAction7, position)
 						}
-						depth--
-						add(rulePegText, position18)
-					}
-					if buffer[position] != rune(':') {
-						goto l13
-					}
-					position++
-					{
-						add(ruleAction6, position)
 					}
+				l18:
 					depth--
 					add(ruleField, position17)
 				}
 				{
-					position28, tokenIndex28, depth28 := position, tokenIndex, depth
+					position35, tokenIndex35, depth35 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l28
+						goto l35
 					}
-					goto l29
-				l28:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					goto l36
+				l35:
+					position, tokenIndex, depth = position35, tokenIndex35, depth35
 				}
-			l29:
+			l36:
 				if !_rules[ruleValue]() {
 					goto l13
 				}
 				{
-					position30, tokenIndex30, depth30 := position, tokenIndex, depth
+					position37, tokenIndex37, depth37 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l30
+						goto l37
 					}
-					goto l31
-				l30:
-					position, tokenIndex, depth = position30, tokenIndex30, depth30
+					goto l38
+				l37:
+					position, tokenIndex, depth = position37, tokenIndex37, depth37
 				}
-			l31:
+			l38:
 				{
 					add(ruleAction2, position)
 				}
@@ -1173,1311 +1268,4066 @@ func (p *LogDocParser) Init() {
 		nil,
 		/* 6 ListElem <- <(S? Value S? Action5)> */
 		func() bool {
-			position35, tokenIndex35, depth35 := position, tokenIndex, depth
+			position42, tokenIndex42, depth42 := position, tokenIndex, depth
 			{
-				position36 := position
+				position43 := position
 				depth++
 				{
-					position37, tokenIndex37, depth37 := position, tokenIndex, depth
+					position44, tokenIndex44, depth44 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l37
+						goto l44
 					}
-					goto l38
-				l37:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
+					goto l45
+				l44:
+					position, tokenIndex, depth = position44, tokenIndex44, depth44
 				}
-			l38:
+			l45:
 				if !_rules[ruleValue]() {
-					goto l35
+					goto l42
 				}
 				{
-					position39, tokenIndex39, depth39 := position, tokenIndex, depth
+					position46, tokenIndex46, depth46 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l39
+						goto l46
 					}
-					goto l40
-				l39:
-					position, tokenIndex, depth = position39, tokenIndex39, depth39
+					goto l47
+				l46:
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
 				}
-			l40:
+			l47:
 				{
 					add(ruleAction5, position)
 				}
 				depth--
-				add(ruleListElem, position36)
+				add(ruleListElem, position43)
 			}
 			return true
-		l35:
-			position, tokenIndex, depth = position35, tokenIndex35, depth35
+		l42:
+			position, tokenIndex, depth = position42, tokenIndex42, depth42
 			return false
 		},
-		/* 7 Field <- <(<fieldChar+> ':' Action6)> */
+		/* 7 Field <- <(('"' <stringChar*> '"' ':' Action6) / (<fieldName> ':' Action7))> */
 		nil,
-		/* 8 Value <- <(Null / MinKey / ((&('M') MaxKey) | (&('u') Undefined) | (&('N') NumberLong) | (&('/') Regex) | (&('T') TimestampVal) | (&('B') BinData) | (&('D' | 'n') Date) | (&('O') ObjectID) | (&('"') String) | (&('f' | 't') Boolean) | (&('[') List) | (&('{') Doc) | (&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') Numeric)))> */
+		/* 8 Value <- <(Numeric / Boolean / Null / ObjectID / Date / BinData / UUID / HexData / TimestampVal / NumberLong / NumberInt / NumberDecimal / Code / CodeWScope / DBRef / DBPointer / Symbol / Constructor / MinKey / ((&('M') MaxKey) | (&('u') Undefined) | (&('f') JavaScript) | (&('/') Regex) | (&('"') String) | (&('[') List) | (&('{') Doc)))> */
 		func() bool {
-			position43, tokenIndex43, depth43 := position, tokenIndex, depth
+			position50, tokenIndex50, depth50 := position, tokenIndex, depth
 			{
-				position44 := position
+				position51 := position
 				depth++
 				{
-					position45, tokenIndex45, depth45 := position, tokenIndex, depth
-					{
-						position47 := position
-						depth++
-						if buffer[position] != rune('n') {
-							goto l46
-						}
-						position++
-						if buffer[position] != rune('u') {
-							goto l46
-						}
-						position++
-						if buffer[position] != rune('l') {
-							goto l46
-						}
-						position++
-						if buffer[position] != rune('l') {
-							goto l46
-						}
-						position++
-						{
-							add(ruleAction9, position)
-						}
-						depth--
-						add(ruleNull, position47)
-					}
-					goto l45
-				l46:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
+					position52, tokenIndex52, depth52 := position, tokenIndex, depth
 					{
-						position50 := position
+						position54 := position
 						depth++
-						if buffer[position] != rune('M') {
-							goto l49
-						}
-						position++
-						if buffer[position] != rune('i') {
-							goto l49
-						}
-						position++
-						if buffer[position] != rune('n') {
-							goto l49
-						}
-						position++
-						if buffer[position] != rune('K') {
-							goto l49
-						}
-						position++
-						if buffer[position] != rune('e') {
-							goto l49
-						}
-						position++
-						if buffer[position] != rune('y') {
-							goto l49
-						}
-						position++
 						{
-							add(ruleAction19, position)
-						}
-						depth--
-						add(ruleMinKey, position50)
-					}
-					goto l45
-				l49:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
-					{
-						switch buffer[position] {
-						case 'M':
+							position55 := position
+							depth++
 							{
-								position53 := position
-								depth++
-								if buffer[position] != rune('M') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('a') {
-									goto l43
+								position56, tokenIndex56, depth56 := position, tokenIndex, depth
+								if buffer[position] != rune('-') {
+									goto l56
 								}
 								position++
-								if buffer[position] != rune('x') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('K') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('y') {
-									goto l43
-								}
-								position++
-								{
-									add(ruleAction20, position)
-								}
-								depth--
-								add(ruleMaxKey, position53)
+								goto l57
+							l56:
+								position, tokenIndex, depth = position56, tokenIndex56, depth56
 							}
-							break
-						case 'u':
+						l57:
 							{
-								position55 := position
-								depth++
-								if buffer[position] != rune('u') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('d') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('f') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('i') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('d') {
-									goto l43
-								}
-								position++
+								position58, tokenIndex58, depth58 := position, tokenIndex, depth
 								{
-									add(ruleAction21, position)
-								}
-								depth--
-								add(ruleUndefined, position55)
-							}
-							break
-						case 'N':
-							{
-								position57 := position
-								depth++
-								if buffer[position] != rune('N') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('u') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('m') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('b') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('r') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('L') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('o') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('g') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('(') {
-									goto l43
+									position60 := position
+									depth++
+									{
+										switch buffer[position] {
+										case 'n':
+											if buffer[position] != rune('n') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l59
+											}
+											position++
+											break
+										case 'N':
+											if buffer[position] != rune('N') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('N') {
+												goto l59
+											}
+											position++
+											break
+										case 'i':
+											if buffer[position] != rune('i') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l59
+											}
+											position++
+											break
+										default:
+											if buffer[position] != rune('I') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l59
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l59
+											}
+											position++
+											break
+										}
+									}
+
+									depth--
+									add(rulenumberSpecial, position60)
 								}
-								position++
+								goto l58
+							l59:
+								position, tokenIndex, depth = position58, tokenIndex58, depth58
 								{
-									position58 := position
+									position63 := position
 									depth++
+									if buffer[position] != rune('0') {
+										goto l62
+									}
+									position++
 									{
-										position61, tokenIndex61, depth61 := position, tokenIndex, depth
-										if buffer[position] != rune(')') {
-											goto l61
+										position64, tokenIndex64, depth64 := position, tokenIndex, depth
+										if buffer[position] != rune('x') {
+											goto l65
+										}
+										position++
+										goto l64
+									l65:
+										position, tokenIndex, depth = position64, tokenIndex64, depth64
+										if buffer[position] != rune('X') {
+											goto l62
 										}
 										position++
-										goto l43
-									l61:
-										position, tokenIndex, depth = position61, tokenIndex61, depth61
 									}
-									if !matchDot() {
-										goto l43
+								l64:
+									if !_rules[rulehexChar]() {
+										goto l62
 									}
-								l59:
+								l66:
 									{
-										position60, tokenIndex60, depth60 := position, tokenIndex, depth
-										{
-											position62, tokenIndex62, depth62 := position, tokenIndex, depth
-											if buffer[position] != rune(')') {
-												goto l62
-											}
-											position++
-											goto l60
-										l62:
-											position, tokenIndex, depth = position62, tokenIndex62, depth62
-										}
-										if !matchDot() {
-											goto l60
+										position67, tokenIndex67, depth67 := position, tokenIndex, depth
+										if !_rules[rulehexChar]() {
+											goto l67
 										}
-										goto l59
-									l60:
-										position, tokenIndex, depth = position60, tokenIndex60, depth60
+										goto l66
+									l67:
+										position, tokenIndex, depth = position67, tokenIndex67, depth67
 									}
 									depth--
-									add(rulePegText, position58)
-								}
-								if buffer[position] != rune(')') {
-									goto l43
-								}
-								position++
-								{
-									add(ruleAction18, position)
-								}
-								depth--
-								add(ruleNumberLong, position57)
-							}
-							break
-						case '/':
-							{
-								position64 := position
-								depth++
-								if buffer[position] != rune('/') {
-									goto l43
+									add(rulenumberHex, position63)
 								}
-								position++
+								goto l58
+							l62:
+								position, tokenIndex, depth = position58, tokenIndex58, depth58
 								{
-									position65 := position
+									position68 := position
 									depth++
 									{
-										position66 := position
-										depth++
+										position69, tokenIndex69, depth69 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l70
+										}
+										position++
+									l71:
 										{
-											position69 := position
-											depth++
-											{
-												position70, tokenIndex70, depth70 := position, tokenIndex, depth
-												if buffer[position] != rune('/') {
-													goto l70
-												}
-												position++
-												goto l43
-											l70:
-												position, tokenIndex, depth = position70, tokenIndex70, depth70
+											position72, tokenIndex72, depth72 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l72
 											}
-											if !matchDot() {
-												goto l43
-											}
-											depth--
-											add(ruleregexChar, position69)
+											position++
+											goto l71
+										l72:
+											position, tokenIndex, depth = position72, tokenIndex72, depth72
 										}
-									l67:
 										{
-											position68, tokenIndex68, depth68 := position, tokenIndex, depth
+											position73, tokenIndex73, depth73 := position, tokenIndex, depth
+											if buffer[position] != rune('.') {
+												goto l73
+											}
+											position++
+										l75:
 											{
-												position71 := position
-												depth++
-												{
-													position72, tokenIndex72, depth72 := position, tokenIndex, depth
-													if buffer[position] != rune('/') {
-														goto l72
-													}
-													position++
-													goto l68
-												l72:
-													position, tokenIndex, depth = position72, tokenIndex72, depth72
-												}
-												if !matchDot() {
-													goto l68
+												position76, tokenIndex76, depth76 := position, tokenIndex, depth
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													goto l76
 												}
-												depth--
-												add(ruleregexChar, position71)
+												position++
+												goto l75
+											l76:
+												position, tokenIndex, depth = position76, tokenIndex76, depth76
 											}
-											goto l67
-										l68:
-											position, tokenIndex, depth = position68, tokenIndex68, depth68
+											goto l74
+										l73:
+											position, tokenIndex, depth = position73, tokenIndex73, depth73
 										}
-										if buffer[position] != rune('/') {
-											goto l43
+									l74:
+										goto l69
+									l70:
+										position, tokenIndex, depth = position69, tokenIndex69, depth69
+										if buffer[position] != rune('.') {
+											goto l53
+										}
+										position++
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l53
 										}
 										position++
-									l73:
+									l77:
 										{
-											position74, tokenIndex74, depth74 := position, tokenIndex, depth
-											{
-												switch buffer[position] {
-												case 's':
-													if buffer[position] != rune('s') {
-														goto l74
-													}
-													position++
-													break
-												case 'm':
-													if buffer[position] != rune('m') {
-														goto l74
-													}
-													position++
-													break
-												case 'i':
-													if buffer[position] != rune('i') {
-														goto l74
-													}
-													position++
-													break
-												default:
-													if buffer[position] != rune('g') {
-														goto l74
-													}
-													position++
-													break
-												}
+											position78, tokenIndex78, depth78 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l78
 											}
-
-											goto l73
-										l74:
-											position, tokenIndex, depth = position74, tokenIndex74, depth74
+											position++
+											goto l77
+										l78:
+											position, tokenIndex, depth = position78, tokenIndex78, depth78
 										}
-										depth--
-										add(ruleregexBody, position66)
 									}
-									depth--
-									add(rulePegText, position65)
-								}
-								{
-									add(ruleAction15, position)
-								}
-								depth--
-								add(ruleRegex, position64)
-							}
-							break
-						case 'T':
-							{
-								position77 := position
-								depth++
-								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
+								l69:
 									{
-										position80 := position
-										depth++
-										if buffer[position] != rune('T') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('i') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('t') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('p') {
-											goto l79
-										}
-										position++
-										if buffer[position] != rune('(') {
-											goto l79
-										}
-										position++
+										position79, tokenIndex79, depth79 := position, tokenIndex, depth
 										{
-											position81 := position
-											depth++
-											{
-												position84, tokenIndex84, depth84 := position, tokenIndex, depth
-												if buffer[position] != rune(')') {
-													goto l84
-												}
-												position++
-												goto l79
-											l84:
-												position, tokenIndex, depth = position84, tokenIndex84, depth84
+											position81, tokenIndex81, depth81 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l82
 											}
-											if !matchDot() {
+											position++
+											goto l81
+										l82:
+											position, tokenIndex, depth = position81, tokenIndex81, depth81
+											if buffer[position] != rune('E') {
 												goto l79
 											}
-										l82:
+											position++
+										}
+									l81:
+										{
+											position83, tokenIndex83, depth83 := position, tokenIndex, depth
 											{
-												position83, tokenIndex83, depth83 := position, tokenIndex, depth
-												{
-													position85, tokenIndex85, depth85 := position, tokenIndex, depth
-													if buffer[position] != rune(')') {
-														goto l85
-													}
-													position++
-													goto l83
-												l85:
-													position, tokenIndex, depth = position85, tokenIndex85, depth85
+												position85, tokenIndex85, depth85 := position, tokenIndex, depth
+												if buffer[position] != rune('-') {
+													goto l86
 												}
-												if !matchDot() {
+												position++
+												goto l85
+											l86:
+												position, tokenIndex, depth = position85, tokenIndex85, depth85
+												if buffer[position] != rune('+') {
 													goto l83
 												}
-												goto l82
-											l83:
-												position, tokenIndex, depth = position83, tokenIndex83, depth83
+												position++
 											}
-											depth--
-											add(rulePegText, position81)
+										l85:
+											goto l84
+										l83:
+											position, tokenIndex, depth = position83, tokenIndex83, depth83
 										}
-										if buffer[position] != rune(')') {
+									l84:
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
 											goto l79
 										}
 										position++
+									l87:
 										{
-											add(ruleAction16, position)
-										}
-										depth--
-										add(ruletimestampParen, position80)
-									}
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
-									{
-										position87 := position
-										depth++
-										if buffer[position] != rune('T') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('i') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('t') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('p') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune(' ') {
-											goto l43
-										}
-										position++
-										{
-											position88 := position
-											depth++
-											{
-												position91, tokenIndex91, depth91 := position, tokenIndex, depth
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l92
-												}
-												position++
-												goto l91
-											l92:
-												position, tokenIndex, depth = position91, tokenIndex91, depth91
-												if buffer[position] != rune('|') {
-													goto l43
-												}
-												position++
-											}
-										l91:
-										l89:
-											{
-												position90, tokenIndex90, depth90 := position, tokenIndex, depth
-												{
-													position93, tokenIndex93, depth93 := position, tokenIndex, depth
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l94
-													}
-													position++
-													goto l93
-												l94:
-													position, tokenIndex, depth = position93, tokenIndex93, depth93
-													if buffer[position] != rune('|') {
-														goto l90
-													}
-													position++
-												}
-											l93:
-												goto l89
-											l90:
-												position, tokenIndex, depth = position90, tokenIndex90, depth90
+											position88, tokenIndex88, depth88 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l88
 											}
-											depth--
-											add(rulePegText, position88)
-										}
-										{
-											add(ruleAction17, position)
+											position++
+											goto l87
+										l88:
+											position, tokenIndex, depth = position88, tokenIndex88, depth88
 										}
-										depth--
-										add(ruletimestampPipe, position87)
+										goto l80
+									l79:
+										position, tokenIndex, depth = position79, tokenIndex79, depth79
 									}
+								l80:
+									depth--
+									add(rulenumberDecimal, position68)
 								}
-							l78:
-								depth--
-								add(ruleTimestampVal, position77)
 							}
-							break
-						case 'B':
-							{
-								position96 := position
-								depth++
-								if buffer[position] != rune('B') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('i') {
-									goto l43
-								}
+						l58:
+							depth--
+							add(rulePegText, position55)
+						}
+						{
+							add(ruleAction8, position)
+						}
+						depth--
+						add(ruleNumeric, position54)
+					}
+					goto l52
+				l53:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position91 := position
+						depth++
+						{
+							position92, tokenIndex92, depth92 := position, tokenIndex, depth
+							{
+								position94 := position
+								depth++
+								if buffer[position] != rune('t') {
+									goto l93
+								}
 								position++
-								if buffer[position] != rune('n') {
-									goto l43
+								if buffer[position] != rune('r') {
+									goto l93
 								}
 								position++
-								if buffer[position] != rune('D') {
-									goto l43
+								if buffer[position] != rune('u') {
+									goto l93
 								}
 								position++
-								if buffer[position] != rune('a') {
-									goto l43
+								if buffer[position] != rune('e') {
+									goto l93
 								}
 								position++
-								if buffer[position] != rune('t') {
-									goto l43
+								{
+									add(ruleAction11, position)
+								}
+								depth--
+								add(ruleTrue, position94)
+							}
+							goto l92
+						l93:
+							position, tokenIndex, depth = position92, tokenIndex92, depth92
+							{
+								position96 := position
+								depth++
+								if buffer[position] != rune('f') {
+									goto l90
 								}
 								position++
 								if buffer[position] != rune('a') {
-									goto l43
+									goto l90
 								}
 								position++
-								if buffer[position] != rune('(') {
-									goto l43
+								if buffer[position] != rune('l') {
+									goto l90
 								}
 								position++
-								{
-									position97 := position
-									depth++
-									{
-										position100, tokenIndex100, depth100 := position, tokenIndex, depth
-										if buffer[position] != rune(')') {
-											goto l100
-										}
-										position++
-										goto l43
-									l100:
-										position, tokenIndex, depth = position100, tokenIndex100, depth100
-									}
-									if !matchDot() {
-										goto l43
-									}
-								l98:
-									{
-										position99, tokenIndex99, depth99 := position, tokenIndex, depth
-										{
-											position101, tokenIndex101, depth101 := position, tokenIndex, depth
-											if buffer[position] != rune(')') {
-												goto l101
-											}
-											position++
-											goto l99
-										l101:
-											position, tokenIndex, depth = position101, tokenIndex101, depth101
-										}
-										if !matchDot() {
-											goto l99
-										}
-										goto l98
-									l99:
-										position, tokenIndex, depth = position99, tokenIndex99, depth99
-									}
-									depth--
-									add(rulePegText, position97)
+								if buffer[position] != rune('s') {
+									goto l90
 								}
-								if buffer[position] != rune(')') {
-									goto l43
+								position++
+								if buffer[position] != rune('e') {
+									goto l90
 								}
 								position++
 								{
-									add(ruleAction14, position)
+									add(ruleAction12, position)
 								}
 								depth--
-								add(ruleBinData, position96)
+								add(ruleFalse, position96)
 							}
-							break
-						case 'D', 'n':
+						}
+					l92:
+						depth--
+						add(ruleBoolean, position91)
+					}
+					goto l52
+				l90:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position99 := position
+						depth++
+						if buffer[position] != rune('n') {
+							goto l98
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l98
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l98
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l98
+						}
+						position++
+						{
+							add(ruleAction10, position)
+						}
+						depth--
+						add(ruleNull, position99)
+					}
+					goto l52
+				l98:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position102 := position
+						depth++
+						if buffer[position] != rune('O') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('j') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l101
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l101
+						}
+						position++
+						{
+							position103, tokenIndex103, depth103 := position, tokenIndex, depth
+							if buffer[position] != rune('\'') {
+								goto l104
+							}
+							position++
+							goto l103
+						l104:
+							position, tokenIndex, depth = position103, tokenIndex103, depth103
+							if buffer[position] != rune('"') {
+								goto l101
+							}
+							position++
+						}
+					l103:
+						{
+							position105 := position
+							depth++
+						l106:
+							{
+								position107, tokenIndex107, depth107 := position, tokenIndex, depth
+								if !_rules[rulehexChar]() {
+									goto l107
+								}
+								goto l106
+							l107:
+								position, tokenIndex, depth = position107, tokenIndex107, depth107
+							}
+							depth--
+							add(rulePegText, position105)
+						}
+						{
+							position108, tokenIndex108, depth108 := position, tokenIndex, depth
+							if buffer[position] != rune('\'') {
+								goto l109
+							}
+							position++
+							goto l108
+						l109:
+							position, tokenIndex, depth = position108, tokenIndex108, depth108
+							if buffer[position] != rune('"') {
+								goto l101
+							}
+							position++
+						}
+					l108:
+						if buffer[position] != rune(')') {
+							goto l101
+						}
+						position++
+						{
+							add(ruleAction15, position)
+						}
+						depth--
+						add(ruleObjectID, position102)
+					}
+					goto l52
+				l101:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position112 := position
+						depth++
+						{
+							position113, tokenIndex113, depth113 := position, tokenIndex, depth
 							{
-								position103 := position
+								position115 := position
 								depth++
 								{
-									position104, tokenIndex104, depth104 := position, tokenIndex, depth
+									position116, tokenIndex116, depth116 := position, tokenIndex, depth
 									if buffer[position] != rune('n') {
-										goto l104
+										goto l116
 									}
 									position++
 									if buffer[position] != rune('e') {
-										goto l104
+										goto l116
 									}
 									position++
 									if buffer[position] != rune('w') {
-										goto l104
+										goto l116
 									}
 									position++
 									if buffer[position] != rune(' ') {
-										goto l104
+										goto l116
 									}
 									position++
-									goto l105
-								l104:
-									position, tokenIndex, depth = position104, tokenIndex104, depth104
+									goto l117
+								l116:
+									position, tokenIndex, depth = position116, tokenIndex116, depth116
 								}
-							l105:
+							l117:
 								if buffer[position] != rune('D') {
-									goto l43
+									goto l114
 								}
 								position++
 								if buffer[position] != rune('a') {
-									goto l43
+									goto l114
 								}
 								position++
 								if buffer[position] != rune('t') {
-									goto l43
+									goto l114
 								}
 								position++
 								if buffer[position] != rune('e') {
-									goto l43
+									goto l114
 								}
 								position++
 								if buffer[position] != rune('(') {
-									goto l43
+									goto l114
 								}
 								position++
 								{
-									position106, tokenIndex106, depth106 := position, tokenIndex, depth
-									if buffer[position] != rune('-') {
-										goto l106
-									}
-									position++
-									goto l107
-								l106:
-									position, tokenIndex, depth = position106, tokenIndex106, depth106
-								}
-							l107:
-								{
-									position108 := position
+									position118 := position
 									depth++
+									{
+										position119, tokenIndex119, depth119 := position, tokenIndex, depth
+										if buffer[position] != rune('-') {
+											goto l119
+										}
+										position++
+										goto l120
+									l119:
+										position, tokenIndex, depth = position119, tokenIndex119, depth119
+									}
+								l120:
 									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l43
+										goto l114
 									}
 									position++
-								l109:
+								l121:
 									{
-										position110, tokenIndex110, depth110 := position, tokenIndex, depth
+										position122, tokenIndex122, depth122 := position, tokenIndex, depth
 										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l110
+											goto l122
 										}
 										position++
-										goto l109
-									l110:
-										position, tokenIndex, depth = position110, tokenIndex110, depth110
+										goto l121
+									l122:
+										position, tokenIndex, depth = position122, tokenIndex122, depth122
 									}
 									depth--
-									add(rulePegText, position108)
+									add(rulePegText, position118)
 								}
 								if buffer[position] != rune(')') {
-									goto l43
+									goto l114
 								}
 								position++
 								{
-									add(ruleAction12, position)
+									add(ruleAction13, position)
 								}
 								depth--
-								add(ruleDate, position103)
+								add(ruledateMillis, position115)
 							}
-							break
-						case 'O':
+							goto l113
+						l114:
+							position, tokenIndex, depth = position113, tokenIndex113, depth113
 							{
-								position112 := position
+								position124 := position
 								depth++
-								if buffer[position] != rune('O') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('b') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('j') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('c') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('t') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('I') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('d') {
-									goto l43
-								}
-								position++
-								if buffer[position] != rune('(') {
-									goto l43
+								{
+									position125, tokenIndex125, depth125 := position, tokenIndex, depth
+									if buffer[position] != rune('n') {
+										goto l125
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l125
+									}
+									position++
+									if buffer[position] != rune('w') {
+										goto l125
+									}
+									position++
+									if buffer[position] != rune(' ') {
+										goto l125
+									}
+									position++
+									goto l126
+								l125:
+									position, tokenIndex, depth = position125, tokenIndex125, depth125
 								}
-								position++
+							l126:
 								{
-									position113, tokenIndex113, depth113 := position, tokenIndex, depth
-									if buffer[position] != rune('\'') {
-										goto l114
+									position127, tokenIndex127, depth127 := position, tokenIndex, depth
+									if buffer[position] != rune('I') {
+										goto l128
 									}
 									position++
-									goto l113
-								l114:
-									position, tokenIndex, depth = position113, tokenIndex113, depth113
-									if buffer[position] != rune('"') {
-										goto l43
+									if buffer[position] != rune('S') {
+										goto l128
+									}
+									position++
+									if buffer[position] != rune('O') {
+										goto l128
+									}
+									position++
+									if buffer[position] != rune('D') {
+										goto l128
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l128
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l128
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l128
+									}
+									position++
+									goto l127
+								l128:
+									position, tokenIndex, depth = position127, tokenIndex127, depth127
+									if buffer[position] != rune('D') {
+										goto l111
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l111
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l111
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l111
 									}
 									position++
 								}
-							l113:
+							l127:
+								if buffer[position] != rune('(') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('"') {
+									goto l111
+								}
+								position++
 								{
-									position115 := position
+									position129 := position
 									depth++
-								l116:
+								l130:
 									{
-										position117, tokenIndex117, depth117 := position, tokenIndex, depth
+										position131, tokenIndex131, depth131 := position, tokenIndex, depth
 										{
-											position118 := position
-											depth++
-											{
-												position119, tokenIndex119, depth119 := position, tokenIndex, depth
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l120
-												}
-												position++
-												goto l119
-											l120:
-												position, tokenIndex, depth = position119, tokenIndex119, depth119
-												{
-													position121, tokenIndex121, depth121 := position, tokenIndex, depth
-													if c := buffer[position]; c < rune('a') || c > rune('f') {
-														goto l122
-													}
-													position++
-													goto l121
-												l122:
-													position, tokenIndex, depth = position121, tokenIndex121, depth121
-													if c := buffer[position]; c < rune('A') || c > rune('F') {
-														goto l117
-													}
-													position++
-												}
-											l121:
+											position132, tokenIndex132, depth132 := position, tokenIndex, depth
+											if buffer[position] != rune('"') {
+												goto l132
 											}
-										l119:
-											depth--
-											add(rulehexChar, position118)
+											position++
+											goto l131
+										l132:
+											position, tokenIndex, depth = position132, tokenIndex132, depth132
 										}
-										goto l116
-									l117:
-										position, tokenIndex, depth = position117, tokenIndex117, depth117
+										if !matchDot() {
+											goto l131
+										}
+										goto l130
+									l131:
+										position, tokenIndex, depth = position131, tokenIndex131, depth131
 									}
 									depth--
-									add(rulePegText, position115)
+									add(rulePegText, position129)
 								}
-								{
-									position123, tokenIndex123, depth123 := position, tokenIndex, depth
-									if buffer[position] != rune('\'') {
-										goto l124
-									}
-									position++
-									goto l123
-								l124:
-									position, tokenIndex, depth = position123, tokenIndex123, depth123
-									if buffer[position] != rune('"') {
-										goto l43
-									}
-									position++
+								if buffer[position] != rune('"') {
+									goto l111
 								}
-							l123:
+								position++
 								if buffer[position] != rune(')') {
-									goto l43
+									goto l111
 								}
 								position++
 								{
-									add(ruleAction13, position)
+									add(ruleAction14, position)
 								}
 								depth--
-								add(ruleObjectID, position112)
+								add(ruledateString, position124)
 							}
-							break
-						case '"':
-							{
-								position126 := position
-								depth++
-								if buffer[position] != rune('"') {
-									goto l43
-								}
+						}
+					l113:
+						depth--
+						add(ruleDate, position112)
+					}
+					goto l52
+				l111:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position135 := position
+						depth++
+						if buffer[position] != rune('B') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l134
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l134
+						}
+						position++
+						{
+							position136 := position
+							depth++
+							{
+								position139, tokenIndex139, depth139 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l139
+								}
+								position++
+								goto l134
+							l139:
+								position, tokenIndex, depth = position139, tokenIndex139, depth139
+							}
+							if !matchDot() {
+								goto l134
+							}
+						l137:
+							{
+								position138, tokenIndex138, depth138 := position, tokenIndex, depth
+								{
+									position140, tokenIndex140, depth140 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l140
+									}
+									position++
+									goto l138
+								l140:
+									position, tokenIndex, depth = position140, tokenIndex140, depth140
+								}
+								if !matchDot() {
+									goto l138
+								}
+								goto l137
+							l138:
+								position, tokenIndex, depth = position138, tokenIndex138, depth138
+							}
+							depth--
+							add(rulePegText, position136)
+						}
+						if buffer[position] != rune(')') {
+							goto l134
+						}
+						position++
+						{
+							add(ruleAction16, position)
+						}
+						depth--
+						add(ruleBinData, position135)
+					}
+					goto l52
+				l134:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position143 := position
+						depth++
+						if buffer[position] != rune('U') {
+							goto l142
+						}
+						position++
+						if buffer[position] != rune('U') {
+							goto l142
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l142
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l142
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l142
+						}
+						position++
+						{
+							position144 := position
+							depth++
+							{
+								position147, tokenIndex147, depth147 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l147
+								}
+								position++
+								goto l142
+							l147:
+								position, tokenIndex, depth = position147, tokenIndex147, depth147
+							}
+							if !matchDot() {
+								goto l142
+							}
+						l145:
+							{
+								position146, tokenIndex146, depth146 := position, tokenIndex, depth
+								{
+									position148, tokenIndex148, depth148 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l148
+									}
+									position++
+									goto l146
+								l148:
+									position, tokenIndex, depth = position148, tokenIndex148, depth148
+								}
+								if !matchDot() {
+									goto l146
+								}
+								goto l145
+							l146:
+								position, tokenIndex, depth = position146, tokenIndex146, depth146
+							}
+							depth--
+							add(rulePegText, position144)
+						}
+						if buffer[position] != rune(')') {
+							goto l142
+						}
+						position++
+						{
+							add(ruleAction17, position)
+						}
+						depth--
+						add(ruleUUID, position143)
+					}
+					goto l52
+				l142:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position151 := position
+						depth++
+						if buffer[position] != rune('H') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('x') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l150
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l150
+						}
+						position++
+						{
+							position152 := position
+							depth++
+							{
+								position155, tokenIndex155, depth155 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l155
+								}
+								position++
+								goto l150
+							l155:
+								position, tokenIndex, depth = position155, tokenIndex155, depth155
+							}
+							if !matchDot() {
+								goto l150
+							}
+						l153:
+							{
+								position154, tokenIndex154, depth154 := position, tokenIndex, depth
+								{
+									position156, tokenIndex156, depth156 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l156
+									}
+									position++
+									goto l154
+								l156:
+									position, tokenIndex, depth = position156, tokenIndex156, depth156
+								}
+								if !matchDot() {
+									goto l154
+								}
+								goto l153
+							l154:
+								position, tokenIndex, depth = position154, tokenIndex154, depth154
+							}
+							depth--
+							add(rulePegText, position152)
+						}
+						if buffer[position] != rune(')') {
+							goto l150
+						}
+						position++
+						{
+							add(ruleAction18, position)
+						}
+						depth--
+						add(ruleHexData, position151)
+					}
+					goto l52
+				l150:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position159 := position
+						depth++
+						{
+							position160, tokenIndex160, depth160 := position, tokenIndex, depth
+							{
+								position162 := position
+								depth++
+								if buffer[position] != rune('T') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l161
+								}
+								position++
+								if buffer[position] != rune('(') {
+									goto l161
+								}
+								position++
+								{
+									position163 := position
+									depth++
+									{
+										position166, tokenIndex166, depth166 := position, tokenIndex, depth
+										if buffer[position] != rune(')') {
+											goto l166
+										}
+										position++
+										goto l161
+									l166:
+										position, tokenIndex, depth = position166, tokenIndex166, depth166
+									}
+									if !matchDot() {
+										goto l161
+									}
+								l164:
+									{
+										position165, tokenIndex165, depth165 := position, tokenIndex, depth
+										{
+											position167, tokenIndex167, depth167 := position, tokenIndex, depth
+											if buffer[position] != rune(')') {
+												goto l167
+											}
+											position++
+											goto l165
+										l167:
+											position, tokenIndex, depth = position167, tokenIndex167, depth167
+										}
+										if !matchDot() {
+											goto l165
+										}
+										goto l164
+									l165:
+										position, tokenIndex, depth = position165, tokenIndex165, depth165
+									}
+									depth--
+									add(rulePegText, position163)
+								}
+								if buffer[position] != rune(')') {
+									goto l161
+								}
+								position++
+								{
+									add(ruleAction20, position)
+								}
+								depth--
+								add(ruletimestampParen, position162)
+							}
+							goto l160
+						l161:
+							position, tokenIndex, depth = position160, tokenIndex160, depth160
+							{
+								position169 := position
+								depth++
+								if buffer[position] != rune('T') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l158
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l158
+								}
+								position++
+								{
+									position170 := position
+									depth++
+									{
+										position173, tokenIndex173, depth173 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l174
+										}
+										position++
+										goto l173
+									l174:
+										position, tokenIndex, depth = position173, tokenIndex173, depth173
+										if buffer[position] != rune('|') {
+											goto l158
+										}
+										position++
+									}
+								l173:
+								l171:
+									{
+										position172, tokenIndex172, depth172 := position, tokenIndex, depth
+										{
+											position175, tokenIndex175, depth175 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l176
+											}
+											position++
+											goto l175
+										l176:
+											position, tokenIndex, depth = position175, tokenIndex175, depth175
+											if buffer[position] != rune('|') {
+												goto l172
+											}
+											position++
+										}
+									l175:
+										goto l171
+									l172:
+										position, tokenIndex, depth = position172, tokenIndex172, depth172
+									}
+									depth--
+									add(rulePegText, position170)
+								}
+								{
+									add(ruleAction21, position)
+								}
+								depth--
+								add(ruletimestampPipe, position169)
+							}
+						}
+					l160:
+						depth--
+						add(ruleTimestampVal, position159)
+					}
+					goto l52
+				l158:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position179 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('L') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l178
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l178
+						}
+						position++
+						{
+							position180 := position
+							depth++
+							{
+								position183, tokenIndex183, depth183 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l183
+								}
+								position++
+								goto l178
+							l183:
+								position, tokenIndex, depth = position183, tokenIndex183, depth183
+							}
+							if !matchDot() {
+								goto l178
+							}
+						l181:
+							{
+								position182, tokenIndex182, depth182 := position, tokenIndex, depth
+								{
+									position184, tokenIndex184, depth184 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l184
+									}
+									position++
+									goto l182
+								l184:
+									position, tokenIndex, depth = position184, tokenIndex184, depth184
+								}
+								if !matchDot() {
+									goto l182
+								}
+								goto l181
+							l182:
+								position, tokenIndex, depth = position182, tokenIndex182, depth182
+							}
+							depth--
+							add(rulePegText, position180)
+						}
+						if buffer[position] != rune(')') {
+							goto l178
+						}
+						position++
+						{
+							add(ruleAction22, position)
+						}
+						depth--
+						add(ruleNumberLong, position179)
+					}
+					goto l52
+				l178:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position187 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l186
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l186
+						}
+						position++
+						{
+							position188 := position
+							depth++
+							{
+								position191, tokenIndex191, depth191 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l191
+								}
+								position++
+								goto l186
+							l191:
+								position, tokenIndex, depth = position191, tokenIndex191, depth191
+							}
+							if !matchDot() {
+								goto l186
+							}
+						l189:
+							{
+								position190, tokenIndex190, depth190 := position, tokenIndex, depth
+								{
+									position192, tokenIndex192, depth192 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l192
+									}
+									position++
+									goto l190
+								l192:
+									position, tokenIndex, depth = position192, tokenIndex192, depth192
+								}
+								if !matchDot() {
+									goto l190
+								}
+								goto l189
+							l190:
+								position, tokenIndex, depth = position190, tokenIndex190, depth190
+							}
+							depth--
+							add(rulePegText, position188)
+						}
+						if buffer[position] != rune(')') {
+							goto l186
+						}
+						position++
+						{
+							add(ruleAction23, position)
+						}
+						depth--
+						add(ruleNumberInt, position187)
+					}
+					goto l52
+				l186:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position195 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l194
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l194
+						}
+						position++
+						{
+							position196 := position
+							depth++
+							{
+								position199, tokenIndex199, depth199 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l199
+								}
+								position++
+								goto l194
+							l199:
+								position, tokenIndex, depth = position199, tokenIndex199, depth199
+							}
+							if !matchDot() {
+								goto l194
+							}
+						l197:
+							{
+								position198, tokenIndex198, depth198 := position, tokenIndex, depth
+								{
+									position200, tokenIndex200, depth200 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l200
+									}
+									position++
+									goto l198
+								l200:
+									position, tokenIndex, depth = position200, tokenIndex200, depth200
+								}
+								if !matchDot() {
+									goto l198
+								}
+								goto l197
+							l198:
+								position, tokenIndex, depth = position198, tokenIndex198, depth198
+							}
+							depth--
+							add(rulePegText, position196)
+						}
+						if buffer[position] != rune(')') {
+							goto l194
+						}
+						position++
+						{
+							add(ruleAction24, position)
+						}
+						depth--
+						add(ruleNumberDecimal, position195)
+					}
+					goto l52
+				l194:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position203 := position
+						depth++
+						if buffer[position] != rune('C') {
+							goto l202
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l202
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l202
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l202
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l202
+						}
+						position++
+						{
+							position204, tokenIndex204, depth204 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l204
+							}
+							goto l205
+						l204:
+							position, tokenIndex, depth = position204, tokenIndex204, depth204
+						}
+					l205:
+						if !_rules[rulecodeArg]() {
+							goto l202
+						}
+						{
+							position206, tokenIndex206, depth206 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l206
+							}
+							goto l207
+						l206:
+							position, tokenIndex, depth = position206, tokenIndex206, depth206
+						}
+					l207:
+						if buffer[position] != rune(')') {
+							goto l202
+						}
+						position++
+						{
+							add(ruleAction26, position)
+						}
+						depth--
+						add(ruleCode, position203)
+					}
+					goto l52
+				l202:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position210 := position
+						depth++
+						if buffer[position] != rune('C') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('W') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('S') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l209
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l209
+						}
+						position++
+						{
+							position211, tokenIndex211, depth211 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l211
+							}
+							goto l212
+						l211:
+							position, tokenIndex, depth = position211, tokenIndex211, depth211
+						}
+					l212:
+						if !_rules[rulecodeArg]() {
+							goto l209
+						}
+						{
+							position213, tokenIndex213, depth213 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l213
+							}
+							goto l214
+						l213:
+							position, tokenIndex, depth = position213, tokenIndex213, depth213
+						}
+					l214:
+						if buffer[position] != rune(',') {
+							goto l209
+						}
+						position++
+						{
+							position215, tokenIndex215, depth215 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l215
+							}
+							goto l216
+						l215:
+							position, tokenIndex, depth = position215, tokenIndex215, depth215
+						}
+					l216:
+						if !_rules[ruleDoc]() {
+							goto l209
+						}
+						{
+							position217, tokenIndex217, depth217 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l217
+							}
+							goto l218
+						l217:
+							position, tokenIndex, depth = position217, tokenIndex217, depth217
+						}
+					l218:
+						if buffer[position] != rune(')') {
+							goto l209
+						}
+						position++
+						{
+							add(ruleAction27, position)
+						}
+						depth--
+						add(ruleCodeWScope, position210)
+					}
+					goto l52
+				l209:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position221 := position
+						depth++
+						if buffer[position] != rune('D') {
+							goto l220
+						}
+						position++
+						if buffer[position] != rune('B') {
+							goto l220
+						}
+						position++
+						if buffer[position] != rune('R') {
+							goto l220
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l220
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l220
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l220
+						}
+						position++
+						{
+							position222, tokenIndex222, depth222 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l222
+							}
+							goto l223
+						l222:
+							position, tokenIndex, depth = position222, tokenIndex222, depth222
+						}
+					l223:
+						if !_rules[rulerefName]() {
+							goto l220
+						}
+						{
+							position224, tokenIndex224, depth224 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l224
+							}
+							goto l225
+						l224:
+							position, tokenIndex, depth = position224, tokenIndex224, depth224
+						}
+					l225:
+						if buffer[position] != rune(',') {
+							goto l220
+						}
+						position++
+						{
+							position226, tokenIndex226, depth226 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l226
+							}
+							goto l227
+						l226:
+							position, tokenIndex, depth = position226, tokenIndex226, depth226
+						}
+					l227:
+						if !_rules[ruleValue]() {
+							goto l220
+						}
+						{
+							position228, tokenIndex228, depth228 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l228
+							}
+							goto l229
+						l228:
+							position, tokenIndex, depth = position228, tokenIndex228, depth228
+						}
+					l229:
+						{
+							position230, tokenIndex230, depth230 := position, tokenIndex, depth
+							if buffer[position] != rune(',') {
+								goto l231
+							}
+							position++
+							{
+								position232, tokenIndex232, depth232 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l232
+								}
+								goto l233
+							l232:
+								position, tokenIndex, depth = position232, tokenIndex232, depth232
+							}
+						l233:
+							if !_rules[rulerefName]() {
+								goto l231
+							}
+							{
+								position234, tokenIndex234, depth234 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l234
+								}
+								goto l235
+							l234:
+								position, tokenIndex, depth = position234, tokenIndex234, depth234
+							}
+						l235:
+							if buffer[position] != rune(')') {
+								goto l231
+							}
+							position++
+							{
+								add(ruleAction28, position)
+							}
+							goto l230
+						l231:
+							position, tokenIndex, depth = position230, tokenIndex230, depth230
+							if buffer[position] != rune(')') {
+								goto l220
+							}
+							position++
+							{
+								add(ruleAction29, position)
+							}
+						}
+					l230:
+						depth--
+						add(ruleDBRef, position221)
+					}
+					goto l52
+				l220:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position239 := position
+						depth++
+						{
+							position240, tokenIndex240, depth240 := position, tokenIndex, depth
+							if buffer[position] != rune('D') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('B') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('P') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l241
+							}
+							position++
+							if buffer[position] != rune('(') {
+								goto l241
+							}
+							position++
+							goto l240
+						l241:
+							position, tokenIndex, depth = position240, tokenIndex240, depth240
+							if buffer[position] != rune('D') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('B') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('R') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('f') {
+								goto l238
+							}
+							position++
+							if buffer[position] != rune('(') {
+								goto l238
+							}
+							position++
+						}
+					l240:
+						{
+							position242, tokenIndex242, depth242 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l242
+							}
+							goto l243
+						l242:
+							position, tokenIndex, depth = position242, tokenIndex242, depth242
+						}
+					l243:
+						if !_rules[rulerefName]() {
+							goto l238
+						}
+						{
+							position244, tokenIndex244, depth244 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l244
+							}
+							goto l245
+						l244:
+							position, tokenIndex, depth = position244, tokenIndex244, depth244
+						}
+					l245:
+						if buffer[position] != rune(',') {
+							goto l238
+						}
+						position++
+						{
+							position246, tokenIndex246, depth246 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l246
+							}
+							goto l247
+						l246:
+							position, tokenIndex, depth = position246, tokenIndex246, depth246
+						}
+					l247:
+						{
+							position248 := position
+							depth++
+							{
+								position249, tokenIndex249, depth249 := position, tokenIndex, depth
+								if buffer[position] != rune('O') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('b') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('j') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('I') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('(') {
+									goto l250
+								}
+								position++
+								{
+									position251, tokenIndex251, depth251 := position, tokenIndex, depth
+									if buffer[position] != rune('\'') {
+										goto l252
+									}
+									position++
+									goto l251
+								l252:
+									position, tokenIndex, depth = position251, tokenIndex251, depth251
+									if buffer[position] != rune('"') {
+										goto l250
+									}
+									position++
+								}
+							l251:
+								{
+									position253 := position
+									depth++
+								l254:
+									{
+										position255, tokenIndex255, depth255 := position, tokenIndex, depth
+										if !_rules[rulehexChar]() {
+											goto l255
+										}
+										goto l254
+									l255:
+										position, tokenIndex, depth = position255, tokenIndex255, depth255
+									}
+									depth--
+									add(rulePegText, position253)
+								}
+								{
+									position256, tokenIndex256, depth256 := position, tokenIndex, depth
+									if buffer[position] != rune('\'') {
+										goto l257
+									}
+									position++
+									goto l256
+								l257:
+									position, tokenIndex, depth = position256, tokenIndex256, depth256
+									if buffer[position] != rune('"') {
+										goto l250
+									}
+									position++
+								}
+							l256:
+								if buffer[position] != rune(')') {
+									goto l250
+								}
+								position++
+								goto l249
+							l250:
+								position, tokenIndex, depth = position249, tokenIndex249, depth249
+								{
+									position258 := position
+									depth++
+									if !_rules[rulehexChar]() {
+										goto l238
+									}
+								l259:
+									{
+										position260, tokenIndex260, depth260 := position, tokenIndex, depth
+										if !_rules[rulehexChar]() {
+											goto l260
+										}
+										goto l259
+									l260:
+										position, tokenIndex, depth = position260, tokenIndex260, depth260
+									}
+									depth--
+									add(rulePegText, position258)
+								}
+							}
+						l249:
+							{
+								add(ruleAction41, position)
+							}
+							depth--
+							add(rulerefId, position248)
+						}
+						{
+							position262, tokenIndex262, depth262 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l262
+							}
+							goto l263
+						l262:
+							position, tokenIndex, depth = position262, tokenIndex262, depth262
+						}
+					l263:
+						if buffer[position] != rune(')') {
+							goto l238
+						}
+						position++
+						{
+							add(ruleAction30, position)
+						}
+						depth--
+						add(ruleDBPointer, position239)
+					}
+					goto l52
+				l238:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position266 := position
+						depth++
+						if buffer[position] != rune('S') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l265
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l265
+						}
+						position++
+						{
+							position267, tokenIndex267, depth267 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l267
+							}
+							goto l268
+						l267:
+							position, tokenIndex, depth = position267, tokenIndex267, depth267
+						}
+					l268:
+						if !_rules[ruleString]() {
+							goto l265
+						}
+						{
+							position269, tokenIndex269, depth269 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l269
+							}
+							goto l270
+						l269:
+							position, tokenIndex, depth = position269, tokenIndex269, depth269
+						}
+					l270:
+						if buffer[position] != rune(')') {
+							goto l265
+						}
+						position++
+						{
+							add(ruleAction31, position)
+						}
+						depth--
+						add(ruleSymbol, position266)
+					}
+					goto l52
+				l265:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position273 := position
+						depth++
+						if !(p.Constructors) {
+							goto l272
+						}
+						{
+							position274, tokenIndex274, depth274 := position, tokenIndex, depth
+							{
+								position275 := position
+								depth++
+								{
+									position276, tokenIndex276, depth276 := position, tokenIndex, depth
+									if buffer[position] != rune('C') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('W') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('S') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('c') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('p') {
+										goto l277
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l277
+									}
+									position++
+									goto l276
+								l277:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('D') {
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l278
+									}
+									position++
+									goto l276
+								l278:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('U') {
+										goto l279
+									}
+									position++
+									if buffer[position] != rune('U') {
+										goto l279
+									}
+									position++
+									if buffer[position] != rune('I') {
+										goto l279
+									}
+									position++
+									if buffer[position] != rune('D') {
+										goto l279
+									}
+									position++
+									goto l276
+								l279:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('N') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('m') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('b') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('L') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l280
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l280
+									}
+									position++
+									goto l276
+								l280:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('N') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('m') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('b') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('I') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l281
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l281
+									}
+									position++
+									goto l276
+								l281:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('R') {
+										goto l282
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l282
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l282
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l282
+									}
+									position++
+									if buffer[position] != rune('x') {
+										goto l282
+									}
+									position++
+									goto l276
+								l282:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('M') {
+										goto l283
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l283
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l283
+									}
+									position++
+									if buffer[position] != rune('K') {
+										goto l283
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l283
+									}
+									position++
+									if buffer[position] != rune('y') {
+										goto l283
+									}
+									position++
+									goto l276
+								l283:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									if buffer[position] != rune('D') {
+										goto l284
+									}
+									position++
+									if buffer[position] != rune('B') {
+										goto l284
+									}
+									position++
+									if buffer[position] != rune('R') {
+										goto l284
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l284
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l284
+									}
+									position++
+									goto l276
+								l284:
+									position, tokenIndex, depth = position276, tokenIndex276, depth276
+									{
+										switch buffer[position] {
+										case 'S':
+											if buffer[position] != rune('S') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l274
+											}
+											position++
+											break
+										case 'D':
+											if buffer[position] != rune('D') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('B') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('P') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l274
+											}
+											position++
+											break
+										case 'U':
+											if buffer[position] != rune('U') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l274
+											}
+											position++
+											break
+										case 'M':
+											if buffer[position] != rune('M') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('K') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l274
+											}
+											position++
+											break
+										case 'R':
+											if buffer[position] != rune('R') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('E') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l274
+											}
+											position++
+											break
+										case 'N':
+											if buffer[position] != rune('N') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l274
+											}
+											position++
+											break
+										case 'T':
+											if buffer[position] != rune('T') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l274
+											}
+											position++
+											break
+										case 'H':
+											if buffer[position] != rune('H') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											break
+										case 'B':
+											if buffer[position] != rune('B') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											break
+										case 'O':
+											if buffer[position] != rune('O') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('j') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('I') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l274
+											}
+											position++
+											break
+										case 'I':
+											if buffer[position] != rune('I') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('S') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('O') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											break
+										default:
+											if buffer[position] != rune('C') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l274
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l274
+											}
+											position++
+											break
+										}
+									}
+
+								}
+							l276:
+								depth--
+								add(rulebuiltinName, position275)
+							}
+							if buffer[position] != rune('(') {
+								goto l274
+							}
+							position++
+							goto l272
+						l274:
+							position, tokenIndex, depth = position274, tokenIndex274, depth274
+						}
+						{
+							position286 := position
+							depth++
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l272
+							}
+							position++
+						l287:
+							{
+								position288, tokenIndex288, depth288 := position, tokenIndex, depth
+								{
+									switch buffer[position] {
+									case '_':
+										if buffer[position] != rune('_') {
+											goto l288
+										}
+										position++
+										break
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l288
+										}
+										position++
+										break
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										if c := buffer[position]; c < rune('A') || c > rune('Z') {
+											goto l288
+										}
+										position++
+										break
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l288
+										}
+										position++
+										break
+									}
+								}
+
+								goto l287
+							l288:
+								position, tokenIndex, depth = position288, tokenIndex288, depth288
+							}
+							depth--
+							add(rulePegText, position286)
+						}
+						if buffer[position] != rune('(') {
+							goto l272
+						}
+						position++
+						{
+							add(ruleAction32, position)
+						}
+						{
+							position291, tokenIndex291, depth291 := position, tokenIndex, depth
+							{
+								position293, tokenIndex293, depth293 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l293
+								}
+								goto l294
+							l293:
+								position, tokenIndex, depth = position293, tokenIndex293, depth293
+							}
+						l294:
+							if !_rules[ruleValue]() {
+								goto l291
+							}
+							{
+								position295, tokenIndex295, depth295 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l295
+								}
+								goto l296
+							l295:
+								position, tokenIndex, depth = position295, tokenIndex295, depth295
+							}
+						l296:
+							{
+								add(ruleAction33, position)
+							}
+						l298:
+							{
+								position299, tokenIndex299, depth299 := position, tokenIndex, depth
+								if buffer[position] != rune(',') {
+									goto l299
+								}
+								position++
+								{
+									position300, tokenIndex300, depth300 := position, tokenIndex, depth
+									if !_rules[ruleS]() {
+										goto l300
+									}
+									goto l301
+								l300:
+									position, tokenIndex, depth = position300, tokenIndex300, depth300
+								}
+							l301:
+								if !_rules[ruleValue]() {
+									goto l299
+								}
+								{
+									position302, tokenIndex302, depth302 := position, tokenIndex, depth
+									if !_rules[ruleS]() {
+										goto l302
+									}
+									goto l303
+								l302:
+									position, tokenIndex, depth = position302, tokenIndex302, depth302
+								}
+							l303:
+								{
+									add(ruleAction34, position)
+								}
+								goto l298
+							l299:
+								position, tokenIndex, depth = position299, tokenIndex299, depth299
+							}
+							goto l292
+						l291:
+							position, tokenIndex, depth = position291, tokenIndex291, depth291
+						}
+					l292:
+						if buffer[position] != rune(')') {
+							goto l272
+						}
+						position++
+						{
+							add(ruleAction35, position)
+						}
+						depth--
+						add(ruleConstructor, position273)
+					}
+					goto l52
+				l272:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						position307 := position
+						depth++
+						if buffer[position] != rune('M') {
+							goto l306
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l306
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l306
+						}
+						position++
+						if buffer[position] != rune('K') {
+							goto l306
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l306
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l306
+						}
+						position++
+						{
+							add(ruleAction36, position)
+						}
+						depth--
+						add(ruleMinKey, position307)
+					}
+					goto l52
+				l306:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					{
+						switch buffer[position] {
+						case 'M':
+							{
+								position310 := position
+								depth++
+								if buffer[position] != rune('M') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('x') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('K') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l50
+								}
+								position++
+								{
+									add(ruleAction37, position)
+								}
+								depth--
+								add(ruleMaxKey, position310)
+							}
+							break
+						case 'u':
+							{
+								position312 := position
+								depth++
+								if buffer[position] != rune('u') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l50
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l50
+								}
+								position++
+								{
+									add(ruleAction38, position)
+								}
+								depth--
+								add(ruleUndefined, position312)
+							}
+							break
+						case 'f':
+							{
+								position314 := position
+								depth++
+								{
+									position315 := position
+									depth++
+									if !_rules[rulejsFunction]() {
+										goto l50
+									}
+									depth--
+									add(rulePegText, position315)
+								}
+								{
+									add(ruleAction25, position)
+								}
+								depth--
+								add(ruleJavaScript, position314)
+							}
+							break
+						case '/':
+							{
+								position317 := position
+								depth++
+								if buffer[position] != rune('/') {
+									goto l50
+								}
 								position++
 								{
-									position127 := position
+									position318 := position
 									depth++
-								l128:
 									{
-										position129, tokenIndex129, depth129 := position, tokenIndex, depth
+										position319 := position
+										depth++
 										{
-											position130 := position
+											position322 := position
 											depth++
 											{
-												position131, tokenIndex131, depth131 := position, tokenIndex, depth
+												position323, tokenIndex323, depth323 := position, tokenIndex, depth
+												if buffer[position] != rune('/') {
+													goto l323
+												}
+												position++
+												goto l50
+											l323:
+												position, tokenIndex, depth = position323, tokenIndex323, depth323
+											}
+											if !matchDot() {
+												goto l50
+											}
+											depth--
+											add(ruleregexChar, position322)
+										}
+									l320:
+										{
+											position321, tokenIndex321, depth321 := position, tokenIndex, depth
+											{
+												position324 := position
+												depth++
 												{
-													position133, tokenIndex133, depth133 := position, tokenIndex, depth
-													{
-														position134, tokenIndex134, depth134 := position, tokenIndex, depth
-														if buffer[position] != rune('"') {
-															goto l135
-														}
-														position++
-														goto l134
-													l135:
-														position, tokenIndex, depth = position134, tokenIndex134, depth134
-														if buffer[position] != rune('\\') {
-															goto l133
-														}
-														position++
+													position325, tokenIndex325, depth325 := position, tokenIndex, depth
+													if buffer[position] != rune('/') {
+														goto l325
 													}
-												l134:
-													goto l132
-												l133:
-													position, tokenIndex, depth = position133, tokenIndex133, depth133
+													position++
+													goto l321
+												l325:
+													position, tokenIndex, depth = position325, tokenIndex325, depth325
 												}
 												if !matchDot() {
-													goto l132
-												}
-												goto l131
-											l132:
-												position, tokenIndex, depth = position131, tokenIndex131, depth131
-												if buffer[position] != rune('\\') {
-													goto l129
+													goto l321
 												}
-												position++
-												{
-													position136, tokenIndex136, depth136 := position, tokenIndex, depth
-													if buffer[position] != rune('"') {
-														goto l137
+												depth--
+												add(ruleregexChar, position324)
+											}
+											goto l320
+										l321:
+											position, tokenIndex, depth = position321, tokenIndex321, depth321
+										}
+										if buffer[position] != rune('/') {
+											goto l50
+										}
+										position++
+									l326:
+										{
+											position327, tokenIndex327, depth327 := position, tokenIndex, depth
+											{
+												switch buffer[position] {
+												case 's':
+													if buffer[position] != rune('s') {
+														goto l327
+													}
+													position++
+													break
+												case 'm':
+													if buffer[position] != rune('m') {
+														goto l327
+													}
+													position++
+													break
+												case 'i':
+													if buffer[position] != rune('i') {
+														goto l327
 													}
 													position++
-													goto l136
-												l137:
-													position, tokenIndex, depth = position136, tokenIndex136, depth136
-													if buffer[position] != rune('\\') {
-														goto l129
+													break
+												default:
+													if buffer[position] != rune('g') {
+														goto l327
 													}
 													position++
+													break
 												}
-											l136:
 											}
-										l131:
-											depth--
-											add(rulestringChar, position130)
+
+											goto l326
+										l327:
+											position, tokenIndex, depth = position327, tokenIndex327, depth327
 										}
-										goto l128
-									l129:
-										position, tokenIndex, depth = position129, tokenIndex129, depth129
+										depth--
+										add(ruleregexBody, position319)
 									}
 									depth--
-									add(rulePegText, position127)
-								}
-								if buffer[position] != rune('"') {
-									goto l43
+									add(rulePegText, position318)
 								}
-								position++
 								{
-									add(ruleAction8, position)
+									add(ruleAction19, position)
 								}
 								depth--
-								add(ruleString, position126)
+								add(ruleRegex, position317)
+							}
+							break
+						case '"':
+							if !_rules[ruleString]() {
+								goto l50
 							}
 							break
-						case 'f', 't':
+						case '[':
 							{
-								position139 := position
+								position330 := position
 								depth++
+								if buffer[position] != rune('[') {
+									goto l50
+								}
+								position++
 								{
-									position140, tokenIndex140, depth140 := position, tokenIndex, depth
+									add(ruleAction3, position)
+								}
+								{
+									position332, tokenIndex332, depth332 := position, tokenIndex, depth
 									{
-										position142 := position
+										position334 := position
 										depth++
-										if buffer[position] != rune('t') {
-											goto l141
-										}
-										position++
-										if buffer[position] != rune('r') {
-											goto l141
-										}
-										position++
-										if buffer[position] != rune('u') {
-											goto l141
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l141
+										if !_rules[ruleListElem]() {
+											goto l332
 										}
-										position++
+									l335:
 										{
-											add(ruleAction10, position)
+											position336, tokenIndex336, depth336 := position, tokenIndex, depth
+											if buffer[position] != rune(',') {
+												goto l336
+											}
+											position++
+											if !_rules[ruleListElem]() {
+												goto l336
+											}
+											goto l335
+										l336:
+											position, tokenIndex, depth = position336, tokenIndex336, depth336
 										}
 										depth--
-										add(ruleTrue, position142)
+										add(ruleListElements, position334)
 									}
-									goto l140
-								l141:
-									position, tokenIndex, depth = position140, tokenIndex140, depth140
-									{
-										position144 := position
-										depth++
-										if buffer[position] != rune('f') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l43
-										}
-										position++
-										if buffer[position] != rune('l') {
-											goto l43
+									goto l333
+								l332:
+									position, tokenIndex, depth = position332, tokenIndex332, depth332
+								}
+							l333:
+								if buffer[position] != rune(']') {
+									goto l50
+								}
+								position++
+								{
+									add(ruleAction4, position)
+								}
+								depth--
+								add(ruleList, position330)
+							}
+							break
+						default:
+							if !_rules[ruleDoc]() {
+								goto l50
+							}
+							break
+						}
+					}
+
+				}
+			l52:
+				depth--
+				add(ruleValue, position51)
+			}
+			return true
+		l50:
+			position, tokenIndex, depth = position50, tokenIndex50, depth50
+			return false
+		},
+		/* 9 Numeric <- <(<('-'? (numberSpecial / numberHex / numberDecimal))> Action8)> */
+		nil,
+		/* 10 Boolean <- <(True / False)> */
+		nil,
+		/* 11 String <- <('"' <stringChar*> '"' Action9)> */
+		func() bool {
+			position340, tokenIndex340, depth340 := position, tokenIndex, depth
+			{
+				position341 := position
+				depth++
+				if buffer[position] != rune('"') {
+					goto l340
+				}
+				position++
+				{
+					position342 := position
+					depth++
+				l343:
+					{
+						position344, tokenIndex344, depth344 := position, tokenIndex, depth
+						if !_rules[rulestringChar]() {
+							goto l344
+						}
+						goto l343
+					l344:
+						position, tokenIndex, depth = position344, tokenIndex344, depth344
+					}
+					depth--
+					add(rulePegText, position342)
+				}
+				if buffer[position] != rune('"') {
+					goto l340
+				}
+				position++
+				{
+					add(ruleAction9, position)
+				}
+				depth--
+				add(ruleString, position341)
+			}
+			return true
+		l340:
+			position, tokenIndex, depth = position340, tokenIndex340, depth340
+			return false
+		},
+		/* 12 Null <- <('n' 'u' 'l' 'l' Action10)> */
+		nil,
+		/* 13 True <- <('t' 'r' 'u' 'e' Action11)> */
+		nil,
+		/* 14 False <- <('f' 'a' 'l' 's' 'e' Action12)> */
+		nil,
+		/* 15 Date <- <(dateMillis / dateString)> */
+		nil,
+		/* 16 dateMillis <- <(('n' 'e' 'w' ' ')? ('D' 'a' 't' 'e' '(') <('-'? [0-9]+)> ')' Action13)> */
+		nil,
+		/* 17 dateString <- <(('n' 'e' 'w' ' ')? (('I' 'S' 'O' 'D' 'a' 't' 'e') / ('D' 'a' 't' 'e')) '(' '"' <(!'"' .)*> '"' ')' Action14)> */
+		nil,
+		/* 18 ObjectID <- <('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')' Action15)> */
+		nil,
+		/* 19 BinData <- <('B' 'i' 'n' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action16)> */
+		nil,
+		/* 20 UUID <- <('U' 'U' 'I' 'D' '(' <(!')' .)+> ')' Action17)> */
+		nil,
+		/* 21 HexData <- <('H' 'e' 'x' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action18)> */
+		nil,
+		/* 22 Regex <- <('/' <regexBody> Action19)> */
+		nil,
+		/* 23 TimestampVal <- <(timestampParen / timestampPipe)> */
+		nil,
+		/* 24 timestampParen <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' '(' <(!')' .)+> ')' Action20)> */
+		nil,
+		/* 25 timestampPipe <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' ' ' <([0-9] / '|')+> Action21)> */
+		nil,
+		/* 26 NumberLong <- <('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g' '(' <(!')' .)+> ')' Action22)> */
+		nil,
+		/* 27 NumberInt <- <('N' 'u' 'm' 'b' 'e' 'r' 'I' 'n' 't' '(' <(!')' .)+> ')' Action23)> */
+		nil,
+		/* 28 NumberDecimal <- <('N' 'u' 'm' 'b' 'e' 'r' 'D' 'e' 'c' 'i' 'm' 'a' 'l' '(' <(!')' .)+> ')' Action24)> */
+		nil,
+		/* 29 JavaScript <- <(<jsFunction> Action25)> */
+		nil,
+		/* 30 Code <- <('C' 'o' 'd' 'e' '(' S? codeArg S? ')' Action26)> */
+		nil,
+		/* 31 CodeWScope <- <('C' 'o' 'd' 'e' 'W' 'S' 'c' 'o' 'p' 'e' '(' S? codeArg S? ',' S? Doc S? ')' Action27)> */
+		nil,
+		/* 32 DBRef <- <('D' 'B' 'R' 'e' 'f' '(' S? refName S? ',' S? Value S? ((',' S? refName S? ')' Action28) / (')' Action29)))> */
+		nil,
+		/* 33 DBPointer <- <((('D' 'B' 'P' 'o' 'i' 'n' 't' 'e' 'r' '(') / ('D' 'B' 'R' 'e' 'f' '(')) S? refName S? ',' S? refId S? ')' Action30)> */
+		nil,
+		/* 34 Symbol <- <('S' 'y' 'm' 'b' 'o' 'l' '(' S? String S? ')' Action31)> */
+		nil,
+		/* 35 Constructor <- <(&{ p.Constructors } !(builtinName '(') <([A-Z] ((&('_') '_') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> '(' Action32 (S? Value S? Action33 (',' S? Value S? Action34)*)? ')' Action35)> */
+		nil,
+		/* 36 builtinName <- <(('C' 'o' 'd' 'e' 'W' 'S' 'c' 'o' 'p' 'e') / ('D' 'a' 't' 'e') / ('U' 'U' 'I' 'D') / ('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g') / ('N' 'u' 'm' 'b' 'e' 'r' 'I' 'n' 't') / ('R' 'e' 'g' 'e' 'x') / ('M' 'i' 'n' 'K' 'e' 'y') / ('D' 'B' 'R' 'e' 'f') / ((&('S') ('S' 'y' 'm' 'b' 'o' 'l')) | (&('D') ('D' 'B' 'P' 'o' 'i' 'n' 't' 'e' 'r')) | (&('U') ('U' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd')) | (&('M') ('M' 'a' 'x' 'K' 'e' 'y')) | (&('R') ('R' 'e' 'g' 'E' 'x' 'p')) | (&('N') ('N' 'u' 'm' 'b' 'e' 'r' 'D' 'e' 'c' 'i' 'm' 'a' 'l')) | (&('T') ('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p')) | (&('H') ('H' 'e' 'x' 'D' 'a' 't' 'a')) | (&('B') ('B' 'i' 'n' 'D' 'a' 't' 'a')) | (&('O') ('O' 'b' 'j' 'e' 'c' 't' 'I' 'd')) | (&('I') ('I' 'S' 'O' 'D' 'a' 't' 'e')) | (&('C') ('C' 'o' 'd' 'e'))))> */
+		nil,
+		/* 37 MinKey <- <('M' 'i' 'n' 'K' 'e' 'y' Action36)> */
+		nil,
+		/* 38 MaxKey <- <('M' 'a' 'x' 'K' 'e' 'y' Action37)> */
+		nil,
+		/* 39 Undefined <- <('u' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd' Action38)> */
+		nil,
+		/* 40 hexChar <- <([0-9] / ([a-f] / [A-F]))> */
+		func() bool {
+			position374, tokenIndex374, depth374 := position, tokenIndex, depth
+			{
+				position375 := position
+				depth++
+				{
+					position376, tokenIndex376, depth376 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l377
+					}
+					position++
+					goto l376
+				l377:
+					position, tokenIndex, depth = position376, tokenIndex376, depth376
+					{
+						position378, tokenIndex378, depth378 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('a') || c > rune('f') {
+							goto l379
+						}
+						position++
+						goto l378
+					l379:
+						position, tokenIndex, depth = position378, tokenIndex378, depth378
+						if c := buffer[position]; c < rune('A') || c > rune('F') {
+							goto l374
+						}
+						position++
+					}
+				l378:
+				}
+			l376:
+				depth--
+				add(rulehexChar, position375)
+			}
+			return true
+		l374:
+			position, tokenIndex, depth = position374, tokenIndex374, depth374
+			return false
+		},
+		/* 41 numberSpecial <- <((&('n') ('n' 'a' 'n')) | (&('N') ('N' 'a' 'N')) | (&('i') ('i' 'n' 'f')) | (&('I') ('I' 'n' 'f' 'i' 'n' 'i' 't' 'y')))> */
+		nil,
+		/* 42 numberHex <- <('0' ('x' / 'X') hexChar+)> */
+		nil,
+		/* 43 numberDecimal <- <((([0-9]+ ('.' [0-9]*)?) / ('.' [0-9]+)) (('e' / 'E') ('-' / '+')? [0-9]+)?)> */
+		nil,
+		/* 44 codeArg <- <(String / (<jsFunction> Action39))> */
+		func() bool {
+			position383, tokenIndex383, depth383 := position, tokenIndex, depth
+			{
+				position384 := position
+				depth++
+				{
+					position385, tokenIndex385, depth385 := position, tokenIndex, depth
+					if !_rules[ruleString]() {
+						goto l386
+					}
+					goto l385
+				l386:
+					position, tokenIndex, depth = position385, tokenIndex385, depth385
+					{
+						position387 := position
+						depth++
+						if !_rules[rulejsFunction]() {
+							goto l383
+						}
+						depth--
+						add(rulePegText, position387)
+					}
+					{
+						add(ruleAction39, position)
+					}
+				}
+			l385:
+				depth--
+				add(rulecodeArg, position384)
+			}
+			return true
+		l383:
+			position, tokenIndex, depth = position383, tokenIndex383, depth383
+			return false
+		},
+		/* 45 jsFunction <- <('f' 'u' 'n' 'c' 't' 'i' 'o' 'n' (!'{' .)* jsBlock)> */
+		func() bool {
+			position389, tokenIndex389, depth389 := position, tokenIndex, depth
+			{
+				position390 := position
+				depth++
+				if buffer[position] != rune('f') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l389
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l389
+				}
+				position++
+			l391:
+				{
+					position392, tokenIndex392, depth392 := position, tokenIndex, depth
+					{
+						position393, tokenIndex393, depth393 := position, tokenIndex, depth
+						if buffer[position] != rune('{') {
+							goto l393
+						}
+						position++
+						goto l392
+					l393:
+						position, tokenIndex, depth = position393, tokenIndex393, depth393
+					}
+					if !matchDot() {
+						goto l392
+					}
+					goto l391
+				l392:
+					position, tokenIndex, depth = position392, tokenIndex392, depth392
+				}
+				if !_rules[rulejsBlock]() {
+					goto l389
+				}
+				depth--
+				add(rulejsFunction, position390)
+			}
+			return true
+		l389:
+			position, tokenIndex, depth = position389, tokenIndex389, depth389
+			return false
+		},
+		/* 46 jsBlock <- <('{' (jsString / jsBlock / (!((&('\'') '\'') | (&('"') '"') | (&('}') '}') | (&('{') '{')) .))* '}')> */
+		func() bool {
+			position394, tokenIndex394, depth394 := position, tokenIndex, depth
+			{
+				position395 := position
+				depth++
+				if buffer[position] != rune('{') {
+					goto l394
+				}
+				position++
+			l396:
+				{
+					position397, tokenIndex397, depth397 := position, tokenIndex, depth
+					{
+						position398, tokenIndex398, depth398 := position, tokenIndex, depth
+						{
+							position400 := position
+							depth++
+							{
+								position401, tokenIndex401, depth401 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l402
+								}
+								position++
+							l403:
+								{
+									position404, tokenIndex404, depth404 := position, tokenIndex, depth
+									{
+										position405, tokenIndex405, depth405 := position, tokenIndex, depth
+										{
+											position407, tokenIndex407, depth407 := position, tokenIndex, depth
+											{
+												position408, tokenIndex408, depth408 := position, tokenIndex, depth
+												if buffer[position] != rune('"') {
+													goto l409
+												}
+												position++
+												goto l408
+											l409:
+												position, tokenIndex, depth = position408, tokenIndex408, depth408
+												if buffer[position] != rune('\\') {
+													goto l407
+												}
+												position++
+											}
+										l408:
+											goto l406
+										l407:
+											position, tokenIndex, depth = position407, tokenIndex407, depth407
 										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l43
+										if !matchDot() {
+											goto l406
 										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l43
+										goto l405
+									l406:
+										position, tokenIndex, depth = position405, tokenIndex405, depth405
+										if buffer[position] != rune('\\') {
+											goto l404
 										}
 										position++
-										{
-											add(ruleAction11, position)
+										if !matchDot() {
+											goto l404
 										}
-										depth--
-										add(ruleFalse, position144)
 									}
+								l405:
+									goto l403
+								l404:
+									position, tokenIndex, depth = position404, tokenIndex404, depth404
 								}
-							l140:
-								depth--
-								add(ruleBoolean, position139)
-							}
-							break
-						case '[':
-							{
-								position146 := position
-								depth++
-								if buffer[position] != rune('[') {
-									goto l43
+								if buffer[position] != rune('"') {
+									goto l402
 								}
 								position++
-								{
-									add(ruleAction3, position)
+								goto l401
+							l402:
+								position, tokenIndex, depth = position401, tokenIndex401, depth401
+								if buffer[position] != rune('\'') {
+									goto l399
 								}
+								position++
+							l410:
 								{
-									position148, tokenIndex148, depth148 := position, tokenIndex, depth
+									position411, tokenIndex411, depth411 := position, tokenIndex, depth
 									{
-										position150 := position
-										depth++
-										if !_rules[ruleListElem]() {
-											goto l148
-										}
-									l151:
+										position412, tokenIndex412, depth412 := position, tokenIndex, depth
 										{
-											position152, tokenIndex152, depth152 := position, tokenIndex, depth
-											if buffer[position] != rune(',') {
-												goto l152
-											}
-											position++
-											if !_rules[ruleListElem]() {
-												goto l152
+											position414, tokenIndex414, depth414 := position, tokenIndex, depth
+											{
+												position415, tokenIndex415, depth415 := position, tokenIndex, depth
+												if buffer[position] != rune('\'') {
+													goto l416
+												}
+												position++
+												goto l415
+											l416:
+												position, tokenIndex, depth = position415, tokenIndex415, depth415
+												if buffer[position] != rune('\\') {
+													goto l414
+												}
+												position++
 											}
-											goto l151
-										l152:
-											position, tokenIndex, depth = position152, tokenIndex152, depth152
+										l415:
+											goto l413
+										l414:
+											position, tokenIndex, depth = position414, tokenIndex414, depth414
+										}
+										if !matchDot() {
+											goto l413
+										}
+										goto l412
+									l413:
+										position, tokenIndex, depth = position412, tokenIndex412, depth412
+										if buffer[position] != rune('\\') {
+											goto l411
+										}
+										position++
+										if !matchDot() {
+											goto l411
 										}
-										depth--
-										add(ruleListElements, position150)
 									}
-									goto l149
-								l148:
-									position, tokenIndex, depth = position148, tokenIndex148, depth148
+								l412:
+									goto l410
+								l411:
+									position, tokenIndex, depth = position411, tokenIndex411, depth411
 								}
-							l149:
-								if buffer[position] != rune(']') {
-									goto l43
+								if buffer[position] != rune('\'') {
+									goto l399
 								}
 								position++
-								{
-									add(ruleAction4, position)
-								}
-								depth--
-								add(ruleList, position146)
 							}
-							break
-						case '{':
-							if !_rules[ruleDoc]() {
-								goto l43
-							}
-							break
-						default:
+						l401:
+							depth--
+							add(rulejsString, position400)
+						}
+						goto l398
+					l399:
+						position, tokenIndex, depth = position398, tokenIndex398, depth398
+						if !_rules[rulejsBlock]() {
+							goto l417
+						}
+						goto l398
+					l417:
+						position, tokenIndex, depth = position398, tokenIndex398, depth398
+						{
+							position418, tokenIndex418, depth418 := position, tokenIndex, depth
 							{
-								position154 := position
-								depth++
-								{
-									position155 := position
-									depth++
-									{
-										position156, tokenIndex156, depth156 := position, tokenIndex, depth
-										if buffer[position] != rune('-') {
-											goto l156
-										}
-										position++
-										goto l157
-									l156:
-										position, tokenIndex, depth = position156, tokenIndex156, depth156
-									}
-								l157:
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l43
+								switch buffer[position] {
+								case '\'':
+									if buffer[position] != rune('\'') {
+										goto l418
 									}
 									position++
-								l158:
-									{
-										position159, tokenIndex159, depth159 := position, tokenIndex, depth
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l159
-										}
-										position++
-										goto l158
-									l159:
-										position, tokenIndex, depth = position159, tokenIndex159, depth159
+									break
+								case '"':
+									if buffer[position] != rune('"') {
+										goto l418
 									}
-									{
-										position160, tokenIndex160, depth160 := position, tokenIndex, depth
-										if buffer[position] != rune('.') {
-											goto l160
-										}
-										position++
-										goto l161
-									l160:
-										position, tokenIndex, depth = position160, tokenIndex160, depth160
+									position++
+									break
+								case '}':
+									if buffer[position] != rune('}') {
+										goto l418
 									}
-								l161:
-								l162:
-									{
-										position163, tokenIndex163, depth163 := position, tokenIndex, depth
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l163
-										}
-										position++
-										goto l162
-									l163:
-										position, tokenIndex, depth = position163, tokenIndex163, depth163
+									position++
+									break
+								default:
+									if buffer[position] != rune('{') {
+										goto l418
 									}
-									depth--
-									add(rulePegText, position155)
+									position++
+									break
 								}
-								{
-									add(ruleAction7, position)
+							}
+
+							goto l397
+						l418:
+							position, tokenIndex, depth = position418, tokenIndex418, depth418
+						}
+						if !matchDot() {
+							goto l397
+						}
+					}
+				l398:
+					goto l396
+				l397:
+					position, tokenIndex, depth = position397, tokenIndex397, depth397
+				}
+				if buffer[position] != rune('}') {
+					goto l394
+				}
+				position++
+				depth--
+				add(rulejsBlock, position395)
+			}
+			return true
+		l394:
+			position, tokenIndex, depth = position394, tokenIndex394, depth394
+			return false
+		},
+		/* 47 jsString <- <(('"' ((!('"' / '\\') .) / ('\\' .))* '"') / ('\'' ((!('\'' / '\\') .) / ('\\' .))* '\''))> */
+		nil,
+		/* 48 refName <- <((('"' <(!'"' .)*> '"') / ('\'' <(!'\'' .)*> '\'')) Action40)> */
+		func() bool {
+			position421, tokenIndex421, depth421 := position, tokenIndex, depth
+			{
+				position422 := position
+				depth++
+				{
+					position423, tokenIndex423, depth423 := position, tokenIndex, depth
+					if buffer[position] != rune('"') {
+						goto l424
+					}
+					position++
+					{
+						position425 := position
+						depth++
+					l426:
+						{
+							position427, tokenIndex427, depth427 := position, tokenIndex, depth
+							{
+								position428, tokenIndex428, depth428 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l428
 								}
-								depth--
-								add(ruleNumeric, position154)
+								position++
+								goto l427
+							l428:
+								position, tokenIndex, depth = position428, tokenIndex428, depth428
 							}
-							break
+							if !matchDot() {
+								goto l427
+							}
+							goto l426
+						l427:
+							position, tokenIndex, depth = position427, tokenIndex427, depth427
 						}
+						depth--
+						add(rulePegText, position425)
 					}
-
+					if buffer[position] != rune('"') {
+						goto l424
+					}
+					position++
+					goto l423
+				l424:
+					position, tokenIndex, depth = position423, tokenIndex423, depth423
+					if buffer[position] != rune('\'') {
+						goto l421
+					}
+					position++
+					{
+						position429 := position
+						depth++
+					l430:
+						{
+							position431, tokenIndex431, depth431 := position, tokenIndex, depth
+							{
+								position432, tokenIndex432, depth432 := position, tokenIndex, depth
+								if buffer[position] != rune('\'') {
+									goto l432
+								}
+								position++
+								goto l431
+							l432:
+								position, tokenIndex, depth = position432, tokenIndex432, depth432
+							}
+							if !matchDot() {
+								goto l431
+							}
+							goto l430
+						l431:
+							position, tokenIndex, depth = position431, tokenIndex431, depth431
+						}
+						depth--
+						add(rulePegText, position429)
+					}
+					if buffer[position] != rune('\'') {
+						goto l421
+					}
+					position++
+				}
+			l423:
+				{
+					add(ruleAction40, position)
 				}
-			l45:
 				depth--
-				add(ruleValue, position44)
+				add(rulerefName, position422)
 			}
 			return true
-		l43:
-			position, tokenIndex, depth = position43, tokenIndex43, depth43
+		l421:
+			position, tokenIndex, depth = position421, tokenIndex421, depth421
 			return false
 		},
-		/* 9 Numeric <- <(<('-'? [0-9]+ '.'? [0-9]*)> Action7)> */
+		/* 49 refId <- <((('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')') / <hexChar+>) Action41)> */
 		nil,
-		/* 10 Boolean <- <(True / False)> */
+		/* 50 regexChar <- <(!'/' .)> */
+		nil,
+		/* 51 regexBody <- <(regexChar+ '/' ((&('s') 's') | (&('m') 'm') | (&('i') 'i') | (&('g') 'g'))*)> */
+		nil,
+		/* 52 stringChar <- <((!('"' / '\\') .) / ('\\' .))> */
+		func() bool {
+			position437, tokenIndex437, depth437 := position, tokenIndex, depth
+			{
+				position438 := position
+				depth++
+				{
+					position439, tokenIndex439, depth439 := position, tokenIndex, depth
+					{
+						position441, tokenIndex441, depth441 := position, tokenIndex, depth
+						{
+							position442, tokenIndex442, depth442 := position, tokenIndex, depth
+							if buffer[position] != rune('"') {
+								goto l443
+							}
+							position++
+							goto l442
+						l443:
+							position, tokenIndex, depth = position442, tokenIndex442, depth442
+							if buffer[position] != rune('\\') {
+								goto l441
+							}
+							position++
+						}
+					l442:
+						goto l440
+					l441:
+						position, tokenIndex, depth = position441, tokenIndex441, depth441
+					}
+					if !matchDot() {
+						goto l440
+					}
+					goto l439
+				l440:
+					position, tokenIndex, depth = position439, tokenIndex439, depth439
+					if buffer[position] != rune('\\') {
+						goto l437
+					}
+					position++
+					if !matchDot() {
+						goto l437
+					}
+				}
+			l439:
+				depth--
+				add(rulestringChar, position438)
+			}
+			return true
+		l437:
+			position, tokenIndex, depth = position437, tokenIndex437, depth437
+			return false
+		},
+		/* 53 fieldName <- <(fieldNameChar+ (' '+ fieldNameChar+)*)> */
 		nil,
-		/* 11 String <- <('"' <stringChar*> '"' Action8)> */
+		/* 54 fieldNameChar <- <(!((&('\n') '\n') | (&('\r') '\r') | (&('\t') '\t') | (&(' ') ' ') | (&('"') '"') | (&(']') ']') | (&('[') '[') | (&('}') '}') | (&('{') '{') | (&(',') ',') | (&(':') ':')) .)> */
+		func() bool {
+			position445, tokenIndex445, depth445 := position, tokenIndex, depth
+			{
+				position446 := position
+				depth++
+				{
+					position447, tokenIndex447, depth447 := position, tokenIndex, depth
+					{
+						switch buffer[position] {
+						case '\n':
+							if buffer[position] != rune('\n') {
+								goto l447
+							}
+							position++
+							break
+						case '\r':
+							if buffer[position] != rune('\r') {
+								goto l447
+							}
+							position++
+							break
+						case '\t':
+							if buffer[position] != rune('\t') {
+								goto l447
+							}
+							position++
+							break
+						case ' ':
+							if buffer[position] != rune(' ') {
+								goto l447
+							}
+							position++
+							break
+						case '"':
+							if buffer[position] != rune('"') {
+								goto l447
+							}
+							position++
+							break
+						case ']':
+							if buffer[position] != rune(']') {
+								goto l447
+							}
+							position++
+							break
+						case '[':
+							if buffer[position] != rune('[') {
+								goto l447
+							}
+							position++
+							break
+						case '}':
+							if buffer[position] != rune('}') {
+								goto l447
+							}
+							position++
+							break
+						case '{':
+							if buffer[position] != rune('{') {
+								goto l447
+							}
+							position++
+							break
+						case ',':
+							if buffer[position] != rune(',') {
+								goto l447
+							}
+							position++
+							break
+						default:
+							if buffer[position] != rune(':') {
+								goto l447
+							}
+							position++
+							break
+						}
+					}
+
+					goto l445
+				l447:
+					position, tokenIndex, depth = position447, tokenIndex447, depth447
+				}
+				if !matchDot() {
+					goto l445
+				}
+				depth--
+				add(rulefieldNameChar, position446)
+			}
+			return true
+		l445:
+			position, tokenIndex, depth = position445, tokenIndex445, depth445
+			return false
+		},
+		/* 55 S <- <' '> */
+		func() bool {
+			position449, tokenIndex449, depth449 := position, tokenIndex, depth
+			{
+				position450 := position
+				depth++
+				if buffer[position] != rune(' ') {
+					goto l449
+				}
+				position++
+				depth--
+				add(ruleS, position450)
+			}
+			return true
+		l449:
+			position, tokenIndex, depth = position449, tokenIndex449, depth449
+			return false
+		},
+		/* 57 Action0 <- <{ p.PushMap() }> */
 		nil,
-		/* 12 Null <- <('n' 'u' 'l' 'l' Action9)> */
+		/* 58 Action1 <- <{ p.PopMap() }> */
 		nil,
-		/* 13 True <- <('t' 'r' 'u' 'e' Action10)> */
+		/* 59 Action2 <- <{ p.SetMapValue() }> */
 		nil,
-		/* 14 False <- <('f' 'a' 'l' 's' 'e' Action11)> */
+		/* 60 Action3 <- <{ p.PushList() }> */
 		nil,
-		/* 15 Date <- <(('n' 'e' 'w' ' ')? ('D' 'a' 't' 'e' '(') '-'? <[0-9]+> ')' Action12)> */
+		/* 61 Action4 <- <{ p.PopList() }> */
 		nil,
-		/* 16 ObjectID <- <('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')' Action13)> */
+		/* 62 Action5 <- <{ p.SetListValue() }> */
 		nil,
-		/* 17 BinData <- <('B' 'i' 'n' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action14)> */
 		nil,
-		/* 18 Regex <- <('/' <regexBody> Action15)> */
+		/* 64 Action6 <- <{ p.PushField(p.Unescape(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 19 TimestampVal <- <(timestampParen / timestampPipe)> */
+		/* 65 Action7 <- <{ p.PushField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 20 timestampParen <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' '(' <(!')' .)+> ')' Action16)> */
+		/* 66 Action8 <- <{ p.PushValue(p.Numeric(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 21 timestampPipe <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' ' ' <([0-9] / '|')+> Action17)> */
+		/* 67 Action9 <- <{ p.PushValue(p.Unescape(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 22 NumberLong <- <('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g' '(' <(!')' .)+> ')' Action18)> */
+		/* 68 Action10 <- <{ p.PushValue(nil) }> */
 		nil,
-		/* 23 MinKey <- <('M' 'i' 'n' 'K' 'e' 'y' Action19)> */
+		/* 69 Action11 <- <{ p.PushValue(true) }> */
 		nil,
-		/* 24 MaxKey <- <('M' 'a' 'x' 'K' 'e' 'y' Action20)> */
+		/* 70 Action12 <- <{ p.PushValue(false) }> */
 		nil,
-		/* 25 Undefined <- <('u' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd' Action21)> */
+		/* 71 Action13 <- <{ p.PushValue(p.Date(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 26 hexChar <- <([0-9] / ([a-f] / [A-F]))> */
+		/* 72 Action14 <- <{ p.PushValue(p.ISODate(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 27 regexChar <- <(!'/' .)> */
+		/* 73 Action15 <- <{ p.PushValue(p.ObjectId(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 28 regexBody <- <(regexChar+ '/' ((&('s') 's') | (&('m') 'm') | (&('i') 'i') | (&('g') 'g'))*)> */
+		/* 74 Action16 <- <{ p.PushValue(p.Bindata(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 29 stringChar <- <((!('"' / '\\') .) / ('\\' ('"' / '\\')))> */
+		/* 75 Action17 <- <{ p.PushValue(p.Uuid(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 30 fieldChar <- <((&('$' | '*' | '.' | '_') ((&('*') '*') | (&('.') '.') | (&('$') '$') | (&('_') '_'))) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		/* 76 Action18 <- <{ p.PushValue(p.Hexdata(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 31 S <- <' '> */
-		func() bool {
-			position187, tokenIndex187, depth187 := position, tokenIndex, depth
-			{
-				position188 := position
-				depth++
-				if buffer[position] != rune(' ') {
-					goto l187
-				}
-				position++
-				depth--
-				add(ruleS, position188)
-			}
-			return true
-		l187:
-			position, tokenIndex, depth = position187, tokenIndex187, depth187
-			return false
-		},
-		/* 33 Action0 <- <{ p.PushMap() }> */
+		/* 77 Action19 <- <{ p.PushValue(p.Regex(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 34 Action1 <- <{ p.PopMap() }> */
+		/* 78 Action20 <- <{ p.PushValue(p.Timestamp(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 35 Action2 <- <{ p.SetMapValue() }> */
+		/* 79 Action21 <- <{ p.PushValue(p.Timestamp(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 36 Action3 <- <{ p.PushList() }> */
+		/* 80 Action22 <- <{ p.PushValue(p.Numberlong(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 37 Action4 <- <{ p.PopList() }> */
+		/* 81 Action23 <- <{ p.PushValue(p.Numberint(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 38 Action5 <- <{ p.SetListValue() }> */
+		/* 82 Action24 <- <{ p.PushValue(p.Numberdecimal(p.text(buffer, begin, end))) }> */
 		nil,
+		/* 83 Action25 <- <{ p.PushValue(p.Javascript(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 40 Action6 <- <{ p.PushField(buffer[begin:end]) }> */
+		/* 84 Action26 <- <{ p.PushValue(p.Javascript(p.PopValue().(string))) }> */
 		nil,
-		/* 41 Action7 <- <{ p.PushValue(p.Numeric(buffer[begin:end])) }> */
+		/* 85 Action27 <- <{ p.PushValue(p.CodeWScope()) }> */
 		nil,
-		/* 42 Action8 <- <{ p.PushValue(buffer[begin:end]) }> */
+		/* 86 Action28 <- <{ p.PushValue(p.DBRef(true)) }> */
 		nil,
-		/* 43 Action9 <- <{ p.PushValue(nil) }> */
+		/* 87 Action29 <- <{ p.PushValue(p.DBRef(false)) }> */
 		nil,
-		/* 44 Action10 <- <{ p.PushValue(true) }> */
+		/* 88 Action30 <- <{ p.PushValue(p.DBPointer()) }> */
 		nil,
-		/* 45 Action11 <- <{ p.PushValue(false) }> */
+		/* 89 Action31 <- <{ p.PushValue(p.Symbol(p.PopValue().(string))) }> */
 		nil,
-		/* 46 Action12 <- <{ p.PushValue(p.Date(buffer[begin:end])) }> */
+		/* 90 Action32 <- <{ p.PushValue(p.text(buffer, begin, end)); p.PushList() }> */
 		nil,
-		/* 47 Action13 <- <{ p.PushValue(p.ObjectId(buffer[begin:end])) }> */
+		/* 91 Action33 <- <{ p.SetListValue() }> */
 		nil,
-		/* 48 Action14 <- <{ p.PushValue(p.Bindata(buffer[begin:end])) }> */
+		/* 92 Action34 <- <{ p.SetListValue() }> */
 		nil,
-		/* 49 Action15 <- <{ p.PushValue(p.Regex(buffer[begin:end])) }> */
+		/* 93 Action35 <- <{ p.PopList(); p.PushValue(p.Constructor()) }> */
 		nil,
-		/* 50 Action16 <- <{ p.PushValue(p.Timestamp(buffer[begin:end])) }> */
+		/* 94 Action36 <- <{ p.PushValue(p.Minkey()) }> */
 		nil,
-		/* 51 Action17 <- <{ p.PushValue(p.Timestamp(buffer[begin:end])) }> */
+		/* 95 Action37 <- <{ p.PushValue(p.Maxkey()) }> */
 		nil,
-		/* 52 Action18 <- <{ p.PushValue(p.Numberlong(buffer[begin:end])) }> */
+		/* 96 Action38 <- <{ p.PushValue(p.Undefined()) }> */
 		nil,
-		/* 53 Action19 <- <{ p.PushValue(p.Minkey()) }> */
+		/* 97 Action39 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 54 Action20 <- <{ p.PushValue(p.Maxkey()) }> */
+		/* 98 Action40 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 55 Action21 <- <{ p.PushValue(p.Undefined()) }> */
+		/* 99 Action41 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
 	}
 	p.rules = _rules
@@ -0,0 +1,84 @@
+package logdoc
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	mongo_json "github.com/mongodb/mongo-tools/common/json"
+)
+
+// Double is a float64 marshaled as canonical extended json, which unlike a
+// json number can represent NaN and the infinities. Integral values are
+// written with a fraction so they stay distinguishable from integers.
+type Double float64
+
+func (d Double) MarshalJSON() ([]byte, error) {
+	f := float64(d)
+	switch {
+	case math.IsNaN(f):
+		return []byte(`{"$numberDouble":"NaN"}`), nil
+	case math.IsInf(f, 1):
+		return []byte(`{"$numberDouble":"Infinity"}`), nil
+	case math.IsInf(f, -1):
+		return []byte(`{"$numberDouble":"-Infinity"}`), nil
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return []byte(s), nil
+}
+
+// NumberDecimal is a 128 bit decimal, kept in its string representation
+type NumberDecimal string
+
+func (n NumberDecimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"$numberDecimal": string(n)})
+}
+
+// Code is JavaScript code, as used by $where and mapReduce, with an optional scope
+type Code struct {
+	Code  string
+	Scope map[string]interface{}
+}
+
+func (c Code) MarshalJSON() ([]byte, error) {
+	if c.Scope == nil {
+		return json.Marshal(map[string]interface{}{"$code": c.Code})
+	}
+	return json.Marshal(map[string]interface{}{"$code": c.Code, "$scope": c.Scope})
+}
+
+// DBRef is a reference to a document in another collection
+type DBRef struct {
+	Collection string
+	Id         interface{}
+	Database   string
+}
+
+func (r DBRef) MarshalJSON() ([]byte, error) {
+	ref := map[string]interface{}{"$ref": r.Collection, "$id": r.Id}
+	if r.Database != "" {
+		ref["$db"] = r.Database
+	}
+	return json.Marshal(ref)
+}
+
+// DBPointer is the deprecated reference type holding a namespace and an ObjectId
+type DBPointer struct {
+	Namespace string
+	Id        mongo_json.ObjectId
+}
+
+func (p DBPointer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"$dbPointer": map[string]interface{}{"$ref": p.Namespace, "$id": p.Id}})
+}
+
+// Symbol is the deprecated symbol type, a string in all but name
+type Symbol string
+
+func (s Symbol) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"$symbol": string(s)})
+}
@@ -0,0 +1,114 @@
+package logline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+// jsonLine is a structured log line written by 4.4 and later servers.
+type jsonLine struct {
+	T struct {
+		Date string `json:"$date"`
+	} `json:"t"`
+	S    string                     `json:"s"`
+	C    string                     `json:"c"`
+	ID   json.Number                `json:"id"`
+	Ctx  string                     `json:"ctx"`
+	Msg  string                     `json:"msg"`
+	Attr map[string]json.RawMessage `json:"attr"`
+}
+
+// parseJSONLine maps a structured log line onto the fields produced for the
+// text formats. The attributes are flattened into the record, with
+// durationMillis as duration_ms and type as op.
+func parseJSONLine(input string) (map[string]interface{}, error) {
+	var line jsonLine
+	d := json.NewDecoder(bytes.NewBufferString(input))
+	d.UseNumber()
+	if err := d.Decode(&line); err != nil {
+		return nil, fmt.Errorf("log_line: parsing json line: %v", err)
+	}
+	if line.T.Date == "" || line.Msg == "" {
+		return nil, fmt.Errorf("log_line: json line is missing the t or msg field")
+	}
+
+	fields := map[string]interface{}{
+		"timestamp": line.T.Date,
+		"severity":  line.S,
+		"component": line.C,
+		"context":   line.Ctx,
+		"msg":       line.Msg,
+	}
+	if line.ID != "" {
+		fields["id"] = jsonValue(line.ID)
+	}
+	for name, raw := range line.Attr {
+		var v interface{}
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("log_line: parsing attribute %s: %v", name, err)
+		}
+		v = jsonValue(v)
+		switch name {
+		case "durationMillis":
+			fields["duration_ms"] = fmt.Sprint(v)
+		case "type":
+			fields["op"] = v
+		case "command":
+			if commandType := firstKey(raw); commandType != "" {
+				fields["command_type"] = commandType
+			}
+			fields[name] = v
+		default:
+			if _, ok := fields[name]; !ok {
+				fields[name] = v
+			}
+		}
+	}
+	return fields, nil
+}
+
+// jsonValue converts the json numbers within v to int64, float64 or
+// logdoc.Double values as produced by the text format parser.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		if math.IsInf(f, 0) || f == math.Trunc(f) {
+			return logdoc.Double(f)
+		}
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+	}
+	return v
+}
+
+// firstKey returns the first field name of a json object, which names the
+// command in a command document.
+func firstKey(raw json.RawMessage) string {
+	d := json.NewDecoder(bytes.NewReader(raw))
+	if t, err := d.Token(); err != nil || t != json.Delim('{') {
+		return ""
+	}
+	if t, err := d.Token(); err == nil {
+		if key, ok := t.(string); ok {
+			return key
+		}
+	}
+	return ""
+}
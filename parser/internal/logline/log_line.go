@@ -2,18 +2,124 @@
 
 package logline
 
-import "github.com/tmc/mongologtools/parser/internal/logdoc"
+import (
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+// RawField is the record field holding the input line when Options.KeepRaw is set.
+const RawField = "raw"
+
+// ExtraField is the record field holding the text the grammar couldn't parse.
+const ExtraField = "xextra"
+
+// Options configure the parsing of a line. Values in json lines are kept as
+// extended json, so the document options only apply to text lines.
+type Options struct {
+	logdoc.Options
+
+	// Version, if set, rejects lines that can't have been written in the
+	// given format generation
+	Version string
+	// KeepRaw records the input line in the raw field
+	KeepRaw bool
+	// Strict rejects lines with trailing text the grammar couldn't parse
+	// instead of recording it in the xextra field
+	Strict bool
+	// Year, if set, places the year-less 2.4 timestamps in the given year,
+	// rewriting them as 2006-01-02T15:04:05.000
+	Year int
+}
+
+// ParseLogLine parses a line in any supported format and records the
+// detected format generation in the log_version field.
 func ParseLogLine(input string) (map[string]interface{}, error) {
-	p := logLineParser{Buffer: input}
-	p.Init()
-	p.logLine.Init()
-	if err := p.Parse(); err != nil {
-		return nil, err
+	return ParseLogLineOptions(input, Options{})
+}
+
+// ParseLogLineVersion is like ParseLogLine but rejects lines that can't have
+// been written in the given format generation. An empty version accepts any.
+func ParseLogLineVersion(input string, version string) (map[string]interface{}, error) {
+	return ParseLogLineOptions(input, Options{Version: version})
+}
+
+// ParseLogLineOptions is like ParseLogLine but configured by opts.
+func ParseLogLineOptions(input string, opts Options) (map[string]interface{}, error) {
+	var (
+		fields   map[string]interface{}
+		detected []string
+	)
+	if strings.HasPrefix(strings.TrimSpace(input), "{") {
+		doc, err := parseJSONLine(input)
+		if err != nil {
+			return nil, err
+		}
+		fields, detected = doc, []string{Version44}
+	} else {
+		p := logLineParser{Buffer: input}
+		p.Init()
+		p.logLine.Init()
+		p.Configure(opts.Options)
+		if err := p.Parse(); err != nil {
+			return nil, err
+		}
+		p.Execute()
+		if err := p.Err(); err != nil {
+			return nil, err
+		}
+		fields, detected = p.Fields, versions(p.version, p.Fields)
+		if extra, ok := fields[ExtraField]; ok && opts.Strict {
+			return nil, fmt.Errorf("log_line: unparsed text %q", extra)
+		}
+		if opts.Year != 0 {
+			fields["timestamp"] = withYear(fields["timestamp"], opts.Year)
+		}
+	}
+	if opts.KeepRaw {
+		fields[RawField] = input
 	}
-	p.Execute()
-	return p.Fields, nil
 
+	fields[VersionField] = detected[0]
+	if opts.Version == "" {
+		return fields, nil
+	}
+	for _, v := range detected {
+		if v == opts.Version {
+			fields[VersionField] = v
+			return fields, nil
+		}
+	}
+	return nil, &ErrVersionMismatch{Version: opts.Version, Detected: strings.Join(detected, "/")}
+}
+
+// layouts of the year-less 2.4 timestamps
+var ctimeLayouts = []string{"Mon Jan _2 15:04:05.000", "Mon Jan _2 15:04:05"}
+
+// withYear rewrites a 2.4 timestamp in the given year, and returns other
+// timestamps unchanged.
+func withYear(timestamp interface{}, year int) interface{} {
+	s, _ := timestamp.(string)
+	for _, layout := range ctimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.AddDate(year, 0, 0).Format("2006-01-02T15:04:05.000")
+		}
+	}
+	return timestamp
+}
+
+// text returns the input between the rune offsets begin and end. Slicing
+// the input rather than the runes keeps invalid utf-8 bytes intact.
+func (p *logLineParser) text(buffer string, begin, end int) string {
+	if p.offsets == nil {
+		p.offsets = logdoc.ByteOffsets(buffer)
+	}
+	if len(p.offsets) == 0 {
+		return buffer[begin:end]
+	}
+	return buffer[p.offsets[begin]:p.offsets[end]]
 }
 
 type logLine struct {
@@ -21,6 +127,8 @@ type logLine struct {
 
 	Fields     map[string]interface{}
 	fieldNames []string
+	version    string
+	offsets    []int
 }
 
 func (m *logLine) Init() {
@@ -29,6 +29,8 @@ const (
 	ruleDuration
 	ruleplainField
 	rulecommandField
+	ruleprotocolField
+	rulehashField
 	ruleplanSummaryField
 	ruleplanSummaryElements
 	ruleplanSummaryElem
@@ -59,6 +61,7 @@ const (
 	rulesecond
 	rulemillisecond
 	ruleletterOrDigit
+	rulefieldChar
 	rulensChar
 	ruleextra
 	ruleS
@@ -77,21 +80,45 @@ const (
 	ruleTrue
 	ruleFalse
 	ruleDate
+	ruledateMillis
+	ruledateString
 	ruleObjectID
 	ruleBinData
+	ruleUUID
+	ruleHexData
 	ruleRegex
 	ruleTimestampVal
 	ruletimestampParen
 	ruletimestampPipe
 	ruleNumberLong
+	ruleNumberInt
+	ruleNumberDecimal
+	ruleJavaScript
+	ruleCode
+	ruleCodeWScope
+	ruleDBRef
+	ruleDBPointer
+	ruleSymbol
+	ruleConstructor
+	rulebuiltinName
 	ruleMinKey
 	ruleMaxKey
 	ruleUndefined
 	rulehexChar
+	rulenumberSpecial
+	rulenumberHex
+	rulenumberDecimal
+	rulecodeArg
+	rulejsFunction
+	rulejsBlock
+	rulejsString
+	rulerefName
+	rulerefId
 	ruleregexChar
 	ruleregexBody
 	rulestringChar
-	rulefieldChar
+	rulefieldName
+	rulefieldNameChar
 	rulePegText
 	ruleAction0
 	ruleAction1
@@ -144,6 +171,29 @@ const (
 	ruleAction48
 	ruleAction49
 	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
 
 	rulePre_
 	rule_In_
@@ -167,6 +217,8 @@ var rul3s = [...]string{
 	"Duration",
 	"plainField",
 	"commandField",
+	"protocolField",
+	"hashField",
 	"planSummaryField",
 	"planSummaryElements",
 	"planSummaryElem",
@@ -197,6 +249,7 @@ var rul3s = [...]string{
 	"second",
 	"millisecond",
 	"letterOrDigit",
+	"fieldChar",
 	"nsChar",
 	"extra",
 	"S",
@@ -215,21 +268,45 @@ var rul3s = [...]string{
 	"True",
 	"False",
 	"Date",
+	"dateMillis",
+	"dateString",
 	"ObjectID",
 	"BinData",
+	"UUID",
+	"HexData",
 	"Regex",
 	"TimestampVal",
 	"timestampParen",
 	"timestampPipe",
 	"NumberLong",
+	"NumberInt",
+	"NumberDecimal",
+	"JavaScript",
+	"Code",
+	"CodeWScope",
+	"DBRef",
+	"DBPointer",
+	"Symbol",
+	"Constructor",
+	"builtinName",
 	"MinKey",
 	"MaxKey",
 	"Undefined",
 	"hexChar",
+	"numberSpecial",
+	"numberHex",
+	"numberDecimal",
+	"codeArg",
+	"jsFunction",
+	"jsBlock",
+	"jsString",
+	"refName",
+	"refId",
 	"regexChar",
 	"regexBody",
 	"stringChar",
-	"fieldChar",
+	"fieldName",
+	"fieldNameChar",
 	"PegText",
 	"Action0",
 	"Action1",
@@ -282,6 +359,29 @@ var rul3s = [...]string{
 	"Action48",
 	"Action49",
 	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
 
 	"Pre_",
 	"_In_",
@@ -850,7 +950,7 @@ type logLineParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [131]func() bool
+	rules  [181]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	tokenTree
@@ -926,115 +1026,167 @@ func (p *logLineParser) Execute() {
 		case rulePegText:
 			begin, end = int(token.begin), int(token.end)
 		case ruleAction0:
-			p.SetField("severity", buffer[begin:end])
+			p.SetField("severity", p.text(buffer, begin, end))
+			p.SetVersion(Version30)
 		case ruleAction1:
-			p.SetField("component", buffer[begin:end])
+			p.SetField("component", p.text(buffer, begin, end))
 		case ruleAction2:
-			p.SetField("context", buffer[begin:end])
+			p.SetField("context", p.text(buffer, begin, end))
 		case ruleAction3:
-			p.SetField("op", buffer[begin:end])
+			p.SetField("op", p.text(buffer, begin, end))
 		case ruleAction4:
-			p.SetField("warning", buffer[begin:end])
+			p.SetField("warning", p.text(buffer, begin, end))
 		case ruleAction5:
-			p.SetField("ns", buffer[begin:end])
+			p.SetField("ns", p.text(buffer, begin, end))
 		case ruleAction6:
-			p.StartField(buffer[begin:end])
+			p.StartField(p.text(buffer, begin, end))
 		case ruleAction7:
 			p.EndField()
 		case ruleAction8:
-			p.SetField("duration_ms", buffer[begin:end])
+			p.SetField("duration_ms", p.text(buffer, begin, end))
 		case ruleAction9:
-			p.StartField(buffer[begin:end])
+			p.StartField(p.text(buffer, begin, end))
 		case ruleAction10:
 			p.EndField()
 		case ruleAction11:
-			p.SetField("command_type", buffer[begin:end])
+			p.SetField("command_type", p.text(buffer, begin, end))
 			p.StartField("command")
 		case ruleAction12:
 			p.EndField()
 		case ruleAction13:
+			p.SetField("protocol", p.text(buffer, begin, end))
+		case ruleAction14:
+			p.StartField(p.text(buffer, begin, end))
+		case ruleAction15:
+			p.PushValue(p.text(buffer, begin, end))
+			p.EndField()
+		case ruleAction16:
 			p.StartField("planSummary")
 			p.PushList()
-		case ruleAction14:
+		case ruleAction17:
 			p.EndField()
-		case ruleAction15:
+		case ruleAction18:
 			p.PushMap()
-			p.PushField(buffer[begin:end])
-		case ruleAction16:
+			p.PushField(p.text(buffer, begin, end))
+		case ruleAction19:
 			p.SetMapValue()
 			p.SetListValue()
-		case ruleAction17:
+		case ruleAction20:
 			p.PushValue(1)
 			p.SetMapValue()
 			p.SetListValue()
-		case ruleAction18:
+		case ruleAction21:
 			p.PushList()
-		case ruleAction19:
+		case ruleAction22:
 			p.PopList()
-		case ruleAction20:
+		case ruleAction23:
 			p.PushMap()
-		case ruleAction21:
+		case ruleAction24:
 			p.SetMapValue()
 			p.SetListValue()
-		case ruleAction22:
-			p.PopMap()
-		case ruleAction23:
-			p.StartField("exception")
-		case ruleAction24:
-			p.PushValue(buffer[begin:end])
-			p.EndField()
 		case ruleAction25:
-			p.PushValue(buffer[begin:end])
+			p.PopMap()
 		case ruleAction26:
-			p.SetField("timestamp", buffer[begin:end])
+			p.StartField("exception")
 		case ruleAction27:
-			p.SetField("timestamp", buffer[begin:end])
+			p.PushValue(p.text(buffer, begin, end))
+			p.EndField()
 		case ruleAction28:
-			p.SetField("xextra", buffer[begin:end])
+			p.PushValue(p.text(buffer, begin, end))
 		case ruleAction29:
-			p.PushMap()
+			p.SetField("timestamp", p.text(buffer, begin, end))
+			p.SetVersion(Version24)
 		case ruleAction30:
-			p.PopMap()
+			p.SetField("timestamp", p.text(buffer, begin, end))
+			p.SetVersion(Version26)
 		case ruleAction31:
-			p.SetMapValue()
+			p.SetField(ExtraField, p.text(buffer, begin, end))
 		case ruleAction32:
-			p.PushList()
+			p.PushMap()
 		case ruleAction33:
-			p.PopList()
+			p.PopMap()
 		case ruleAction34:
-			p.SetListValue()
+			p.SetMapValue()
 		case ruleAction35:
-			p.PushField(buffer[begin:end])
+			p.PushList()
 		case ruleAction36:
-			p.PushValue(p.Numeric(buffer[begin:end]))
+			p.PopList()
 		case ruleAction37:
-			p.PushValue(buffer[begin:end])
+			p.SetListValue()
 		case ruleAction38:
-			p.PushValue(nil)
+			p.PushField(p.Unescape(p.text(buffer, begin, end)))
 		case ruleAction39:
-			p.PushValue(true)
+			p.PushField(p.text(buffer, begin, end))
 		case ruleAction40:
-			p.PushValue(false)
+			p.PushValue(p.Numeric(p.text(buffer, begin, end)))
 		case ruleAction41:
-			p.PushValue(p.Date(buffer[begin:end]))
+			p.PushValue(p.Unescape(p.text(buffer, begin, end)))
 		case ruleAction42:
-			p.PushValue(p.ObjectId(buffer[begin:end]))
+			p.PushValue(nil)
 		case ruleAction43:
-			p.PushValue(p.Bindata(buffer[begin:end]))
+			p.PushValue(true)
 		case ruleAction44:
-			p.PushValue(p.Regex(buffer[begin:end]))
+			p.PushValue(false)
 		case ruleAction45:
-			p.PushValue(p.Timestamp(buffer[begin:end]))
+			p.PushValue(p.Date(p.text(buffer, begin, end)))
 		case ruleAction46:
-			p.PushValue(p.Timestamp(buffer[begin:end]))
+			p.PushValue(p.ISODate(p.text(buffer, begin, end)))
 		case ruleAction47:
-			p.PushValue(p.Numberlong(buffer[begin:end]))
+			p.PushValue(p.ObjectId(p.text(buffer, begin, end)))
 		case ruleAction48:
-			p.PushValue(p.Minkey())
+			p.PushValue(p.Bindata(p.text(buffer, begin, end)))
 		case ruleAction49:
-			p.PushValue(p.Maxkey())
+			p.PushValue(p.Uuid(p.text(buffer, begin, end)))
 		case ruleAction50:
+			p.PushValue(p.Hexdata(p.text(buffer, begin, end)))
+		case ruleAction51:
+			p.PushValue(p.Regex(p.text(buffer, begin, end)))
+		case ruleAction52:
+			p.PushValue(p.Timestamp(p.text(buffer, begin, end)))
+		case ruleAction53:
+			p.PushValue(p.Timestamp(p.text(buffer, begin, end)))
+		case ruleAction54:
+			p.PushValue(p.Numberlong(p.text(buffer, begin, end)))
+		case ruleAction55:
+			p.PushValue(p.Numberint(p.text(buffer, begin, end)))
+		case ruleAction56:
+			p.PushValue(p.Numberdecimal(p.text(buffer, begin, end)))
+		case ruleAction57:
+			p.PushValue(p.Javascript(p.text(buffer, begin, end)))
+		case ruleAction58:
+			p.PushValue(p.Javascript(p.PopValue().(string)))
+		case ruleAction59:
+			p.PushValue(p.CodeWScope())
+		case ruleAction60:
+			p.PushValue(p.DBRef(true))
+		case ruleAction61:
+			p.PushValue(p.DBRef(false))
+		case ruleAction62:
+			p.PushValue(p.DBPointer())
+		case ruleAction63:
+			p.PushValue(p.Symbol(p.PopValue().(string)))
+		case ruleAction64:
+			p.PushValue(p.text(buffer, begin, end))
+			p.PushList()
+		case ruleAction65:
+			p.SetListValue()
+		case ruleAction66:
+			p.SetListValue()
+		case ruleAction67:
+			p.PopList()
+			p.PushValue(p.Constructor())
+		case ruleAction68:
+			p.PushValue(p.Minkey())
+		case ruleAction69:
+			p.PushValue(p.Maxkey())
+		case ruleAction70:
 			p.PushValue(p.Undefined())
+		case ruleAction71:
+			p.PushValue(p.text(buffer, begin, end))
+		case ruleAction72:
+			p.PushValue(p.text(buffer, begin, end))
+		case ruleAction73:
+			p.PushValue(p.text(buffer, begin, end))
 
 		}
 	}
@@ -1221,7 +1373,7 @@ func (p *logLineParser) Init() {
 								add(rulePegText, position8)
 							}
 							{
-								add(ruleAction26, position)
+								add(ruleAction29, position)
 							}
 							depth--
 							add(ruletimestamp24, position7)
@@ -1320,7 +1472,7 @@ func (p *logLineParser) Init() {
 								add(rulePegText, position19)
 							}
 							{
-								add(ruleAction27, position)
+								add(ruleAction30, position)
 							}
 							depth--
 							add(ruletimestamp26, position18)
@@ -2335,7 +2487,7 @@ func (p *logLineParser) Init() {
 							add(rulePegText, position114)
 						}
 						{
-							add(ruleAction28, position)
+							add(ruleAction31, position)
 						}
 						depth--
 						add(ruleextra, position113)
@@ -2376,7 +2528,7 @@ func (p *logLineParser) Init() {
 		nil,
 		/* 7 loglineSizeWarning <- <('w' 'a' 'r' 'n' 'i' 'n' 'g' ':' ' ' 'l' 'o' 'g' ' ' 'l' 'i' 'n' 'e' ' ' 'a' 't' 't' 'e' 'm' 'p' 't' 'e' 'd' ' ' '(' [0-9]+ ('k' ')' ' ' 'o' 'v' 'e' 'r' ' ' 'm' 'a' 'x' ' ' 's' 'i' 'z' 'e' ' ' '(') [0-9]+ ('k' ')' ',' ' ' 'p' 'r' 'i' 'n' 't' 'i' 'n' 'g' ' ' 'b' 'e' 'g' 'i' 'n' 'n' 'i' 'n' 'g' ' ' 'a' 'n' 'd' ' ' 'e' 'n' 'd' ' ' '.' '.' '.'))> */
 		nil,
-		/* 8 LineField <- <((exceptionField / commandField / planSummaryField / plainField) S?)> */
+		/* 8 LineField <- <((exceptionField / commandField / planSummaryField / protocolField / hashField / plainField) S?)> */
 		func() bool {
 			position126, tokenIndex126, depth126 := position, tokenIndex, depth
 			{
@@ -2428,7 +2580,7 @@ func (p *logLineParser) Init() {
 						}
 						position++
 						{
-							add(ruleAction23, position)
+							add(ruleAction26, position)
 						}
 						{
 							position132 := position
@@ -2526,7 +2678,7 @@ func (p *logLineParser) Init() {
 						}
 					l140:
 						{
-							add(ruleAction24, position)
+							add(ruleAction27, position)
 						}
 						depth--
 						add(ruleexceptionField, position130)
@@ -2673,7 +2825,7 @@ func (p *logLineParser) Init() {
 						}
 						position++
 						{
-							add(ruleAction13, position)
+							add(ruleAction16, position)
 						}
 						{
 							position154 := position
@@ -2703,7 +2855,7 @@ func (p *logLineParser) Init() {
 							add(ruleplanSummaryElements, position154)
 						}
 						{
-							add(ruleAction14, position)
+							add(ruleAction17, position)
 						}
 						depth--
 						add(ruleplanSummaryField, position152)
@@ -2712,41 +2864,313 @@ func (p *logLineParser) Init() {
 				l151:
 					position, tokenIndex, depth = position128, tokenIndex128, depth128
 					{
-						position158 := position
+						position159 := position
+						depth++
+						if buffer[position] != rune('p') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l158
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l158
+						}
+						position++
+						{
+							position160 := position
+							depth++
+							{
+								position163, tokenIndex163, depth163 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l164
+								}
+								position++
+								goto l163
+							l164:
+								position, tokenIndex, depth = position163, tokenIndex163, depth163
+								if buffer[position] != rune('_') {
+									goto l158
+								}
+								position++
+							}
+						l163:
+						l161:
+							{
+								position162, tokenIndex162, depth162 := position, tokenIndex, depth
+								{
+									position165, tokenIndex165, depth165 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l166
+									}
+									position++
+									goto l165
+								l166:
+									position, tokenIndex, depth = position165, tokenIndex165, depth165
+									if buffer[position] != rune('_') {
+										goto l162
+									}
+									position++
+								}
+							l165:
+								goto l161
+							l162:
+								position, tokenIndex, depth = position162, tokenIndex162, depth162
+							}
+							depth--
+							add(rulePegText, position160)
+						}
+						{
+							position167, tokenIndex167, depth167 := position, tokenIndex, depth
+							{
+								position168, tokenIndex168, depth168 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l169
+								}
+								goto l168
+							l169:
+								position, tokenIndex, depth = position168, tokenIndex168, depth168
+								{
+									position170, tokenIndex170, depth170 := position, tokenIndex, depth
+									if !matchDot() {
+										goto l170
+									}
+									goto l158
+								l170:
+									position, tokenIndex, depth = position170, tokenIndex170, depth170
+								}
+							}
+						l168:
+							position, tokenIndex, depth = position167, tokenIndex167, depth167
+						}
+						{
+							add(ruleAction13, position)
+						}
+						depth--
+						add(ruleprotocolField, position159)
+					}
+					goto l128
+				l158:
+					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					{
+						position173 := position
+						depth++
+						{
+							position174 := position
+							depth++
+							{
+								position175, tokenIndex175, depth175 := position, tokenIndex, depth
+								if buffer[position] != rune('q') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('H') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l176
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l176
+								}
+								position++
+								goto l175
+							l176:
+								position, tokenIndex, depth = position175, tokenIndex175, depth175
+								if buffer[position] != rune('p') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('C') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('K') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l172
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l172
+								}
+								position++
+							}
+						l175:
+							depth--
+							add(rulePegText, position174)
+						}
+						if buffer[position] != rune(':') {
+							goto l172
+						}
+						position++
+						{
+							add(ruleAction14, position)
+						}
+						{
+							position178 := position
+							depth++
+							if !_rules[rulehexChar]() {
+								goto l172
+							}
+						l179:
+							{
+								position180, tokenIndex180, depth180 := position, tokenIndex, depth
+								if !_rules[rulehexChar]() {
+									goto l180
+								}
+								goto l179
+							l180:
+								position, tokenIndex, depth = position180, tokenIndex180, depth180
+							}
+							depth--
+							add(rulePegText, position178)
+						}
+						{
+							position181, tokenIndex181, depth181 := position, tokenIndex, depth
+							{
+								position182, tokenIndex182, depth182 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l183
+								}
+								goto l182
+							l183:
+								position, tokenIndex, depth = position182, tokenIndex182, depth182
+								{
+									position184, tokenIndex184, depth184 := position, tokenIndex, depth
+									if !matchDot() {
+										goto l184
+									}
+									goto l172
+								l184:
+									position, tokenIndex, depth = position184, tokenIndex184, depth184
+								}
+							}
+						l182:
+							position, tokenIndex, depth = position181, tokenIndex181, depth181
+						}
+						{
+							add(ruleAction15, position)
+						}
+						depth--
+						add(rulehashField, position173)
+					}
+					goto l128
+				l172:
+					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					{
+						position186 := position
 						depth++
 						{
-							position159 := position
+							position187 := position
 							depth++
 							if !_rules[rulefieldChar]() {
 								goto l126
 							}
-						l160:
+						l188:
 							{
-								position161, tokenIndex161, depth161 := position, tokenIndex, depth
+								position189, tokenIndex189, depth189 := position, tokenIndex, depth
 								if !_rules[rulefieldChar]() {
-									goto l161
+									goto l189
 								}
-								goto l160
-							l161:
-								position, tokenIndex, depth = position161, tokenIndex161, depth161
+								goto l188
+							l189:
+								position, tokenIndex, depth = position189, tokenIndex189, depth189
 							}
 							depth--
-							add(rulePegText, position159)
+							add(rulePegText, position187)
 						}
 						if buffer[position] != rune(':') {
 							goto l126
 						}
 						position++
 						{
-							position162, tokenIndex162, depth162 := position, tokenIndex, depth
+							position190, tokenIndex190, depth190 := position, tokenIndex, depth
 							if !_rules[ruleS]() {
-								goto l162
+								goto l190
 							}
-							goto l163
-						l162:
-							position, tokenIndex, depth = position162, tokenIndex162, depth162
+							goto l191
+						l190:
+							position, tokenIndex, depth = position190, tokenIndex190, depth190
 						}
-					l163:
+					l191:
 						{
 							add(ruleAction9, position)
 						}
@@ -2757,20 +3181,20 @@ func (p *logLineParser) Init() {
 							add(ruleAction10, position)
 						}
 						depth--
-						add(ruleplainField, position158)
+						add(ruleplainField, position186)
 					}
 				}
 			l128:
 				{
-					position166, tokenIndex166, depth166 := position, tokenIndex, depth
+					position194, tokenIndex194, depth194 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l166
+						goto l194
 					}
-					goto l167
-				l166:
-					position, tokenIndex, depth = position166, tokenIndex166, depth166
+					goto l195
+				l194:
+					position, tokenIndex, depth = position194, tokenIndex194, depth194
 				}
-			l167:
+			l195:
 				depth--
 				add(ruleLineField, position127)
 			}
@@ -2791,2264 +3215,5133 @@ func (p *logLineParser) Init() {
 		nil,
 		/* 14 commandField <- <('c' 'o' 'm' 'm' 'a' 'n' 'd' ':' ' ' <fieldChar+> S? Action11 LineValue Action12)> */
 		nil,
-		/* 15 planSummaryField <- <('p' 'l' 'a' 'n' 'S' 'u' 'm' 'm' 'a' 'r' 'y' ':' ' ' Action13 planSummaryElements Action14)> */
+		/* 15 protocolField <- <('p' 'r' 'o' 't' 'o' 'c' 'o' 'l' ':' <([a-z] / '_')+> &(S / !.) Action13)> */
 		nil,
-		/* 16 planSummaryElements <- <(planSummaryElem (',' ' ' planSummaryElem)*)> */
+		/* 16 hashField <- <(<(('q' 'u' 'e' 'r' 'y' 'H' 'a' 's' 'h') / ('p' 'l' 'a' 'n' 'C' 'a' 'c' 'h' 'e' 'K' 'e' 'y'))> ':' Action14 <hexChar+> &(S / !.) Action15)> */
 		nil,
-		/* 17 planSummaryElem <- <(<planSummaryStage> Action15 planSummary)> */
+		/* 17 planSummaryField <- <('p' 'l' 'a' 'n' 'S' 'u' 'm' 'm' 'a' 'r' 'y' ':' ' ' Action16 planSummaryElements Action17)> */
+		nil,
+		/* 18 planSummaryElements <- <(planSummaryElem (',' ' ' planSummaryElem)*)> */
+		nil,
+		/* 19 planSummaryElem <- <(<planSummaryStage> Action18 planSummary)> */
 		func() bool {
-			position176, tokenIndex176, depth176 := position, tokenIndex, depth
+			position206, tokenIndex206, depth206 := position, tokenIndex, depth
 			{
-				position177 := position
+				position207 := position
 				depth++
 				{
-					position178 := position
+					position208 := position
 					depth++
 					{
-						position179 := position
+						position209 := position
 						depth++
 						{
 							switch buffer[position] {
 							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l176
+									goto l206
 								}
 								position++
 								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l176
+									goto l206
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l176
+									goto l206
 								}
 								position++
 								break
 							}
 						}
 
-					l180:
+					l210:
 						{
-							position181, tokenIndex181, depth181 := position, tokenIndex, depth
+							position211, tokenIndex211, depth211 := position, tokenIndex, depth
 							{
 								switch buffer[position] {
 								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l181
+										goto l211
 									}
 									position++
 									break
 								case '_':
 									if buffer[position] != rune('_') {
-										goto l181
+										goto l211
 									}
 									position++
 									break
 								default:
 									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l181
+										goto l211
 									}
 									position++
 									break
 								}
 							}
 
-							goto l180
-						l181:
-							position, tokenIndex, depth = position181, tokenIndex181, depth181
+							goto l210
+						l211:
+							position, tokenIndex, depth = position211, tokenIndex211, depth211
 						}
 						depth--
-						add(ruleplanSummaryStage, position179)
+						add(ruleplanSummaryStage, position209)
 					}
 					depth--
-					add(rulePegText, position178)
+					add(rulePegText, position208)
 				}
 				{
-					add(ruleAction15, position)
+					add(ruleAction18, position)
 				}
 				{
-					position185 := position
+					position215 := position
 					depth++
 					{
-						position186, tokenIndex186, depth186 := position, tokenIndex, depth
+						position216, tokenIndex216, depth216 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l187
+							goto l217
 						}
 						position++
 						{
-							position188 := position
+							position218 := position
 							depth++
 							if buffer[position] != rune('{') {
-								goto l187
+								goto l217
 							}
 							position++
 							{
-								add(ruleAction18, position)
+								add(ruleAction21, position)
 							}
 							{
-								position190, tokenIndex190, depth190 := position, tokenIndex, depth
+								position220, tokenIndex220, depth220 := position, tokenIndex, depth
 								{
-									position192 := position
+									position222 := position
 									depth++
 									if !_rules[ruleOrderedDocElem]() {
-										goto l190
+										goto l220
 									}
-								l193:
+								l223:
 									{
-										position194, tokenIndex194, depth194 := position, tokenIndex, depth
+										position224, tokenIndex224, depth224 := position, tokenIndex, depth
 										if buffer[position] != rune(',') {
-											goto l194
+											goto l224
 										}
 										position++
 										if !_rules[ruleOrderedDocElem]() {
-											goto l194
+											goto l224
 										}
-										goto l193
-									l194:
-										position, tokenIndex, depth = position194, tokenIndex194, depth194
+										goto l223
+									l224:
+										position, tokenIndex, depth = position224, tokenIndex224, depth224
 									}
 									depth--
-									add(ruleOrderedDocElements, position192)
+									add(ruleOrderedDocElements, position222)
 								}
-								goto l191
-							l190:
-								position, tokenIndex, depth = position190, tokenIndex190, depth190
+								goto l221
+							l220:
+								position, tokenIndex, depth = position220, tokenIndex220, depth220
 							}
-						l191:
+						l221:
 							if buffer[position] != rune('}') {
-								goto l187
+								goto l217
 							}
 							position++
 							{
-								add(ruleAction19, position)
+								add(ruleAction22, position)
 							}
 							depth--
-							add(ruleOrderedDoc, position188)
+							add(ruleOrderedDoc, position218)
 						}
 						{
-							add(ruleAction16, position)
+							add(ruleAction19, position)
 						}
-						goto l186
-					l187:
-						position, tokenIndex, depth = position186, tokenIndex186, depth186
+						goto l216
+					l217:
+						position, tokenIndex, depth = position216, tokenIndex216, depth216
 						{
-							add(ruleAction17, position)
+							add(ruleAction20, position)
 						}
 					}
-				l186:
+				l216:
 					depth--
-					add(ruleplanSummary, position185)
+					add(ruleplanSummary, position215)
 				}
 				depth--
-				add(ruleplanSummaryElem, position177)
+				add(ruleplanSummaryElem, position207)
 			}
 			return true
-		l176:
-			position, tokenIndex, depth = position176, tokenIndex176, depth176
+		l206:
+			position, tokenIndex, depth = position206, tokenIndex206, depth206
 			return false
 		},
-		/* 18 planSummaryStage <- <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]))+> */
+		/* 20 planSummaryStage <- <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]))+> */
 		nil,
-		/* 19 planSummary <- <((' ' OrderedDoc Action16) / Action17)> */
+		/* 21 planSummary <- <((' ' OrderedDoc Action19) / Action20)> */
 		nil,
-		/* 20 OrderedDoc <- <('{' Action18 OrderedDocElements? '}' Action19)> */
+		/* 22 OrderedDoc <- <('{' Action21 OrderedDocElements? '}' Action22)> */
 		nil,
-		/* 21 OrderedDocElements <- <(OrderedDocElem (',' OrderedDocElem)*)> */
+		/* 23 OrderedDocElements <- <(OrderedDocElem (',' OrderedDocElem)*)> */
 		nil,
-		/* 22 OrderedDocElem <- <(S? Action20 Field S? Value Action21 S? Action22)> */
+		/* 24 OrderedDocElem <- <(S? Action23 Field S? Value Action24 S? Action25)> */
 		func() bool {
-			position202, tokenIndex202, depth202 := position, tokenIndex, depth
+			position232, tokenIndex232, depth232 := position, tokenIndex, depth
 			{
-				position203 := position
+				position233 := position
 				depth++
 				{
-					position204, tokenIndex204, depth204 := position, tokenIndex, depth
+					position234, tokenIndex234, depth234 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l204
+						goto l234
 					}
-					goto l205
-				l204:
-					position, tokenIndex, depth = position204, tokenIndex204, depth204
+					goto l235
+				l234:
+					position, tokenIndex, depth = position234, tokenIndex234, depth234
 				}
-			l205:
+			l235:
 				{
-					add(ruleAction20, position)
+					add(ruleAction23, position)
 				}
 				if !_rules[ruleField]() {
-					goto l202
+					goto l232
 				}
 				{
-					position207, tokenIndex207, depth207 := position, tokenIndex, depth
+					position237, tokenIndex237, depth237 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l207
+						goto l237
 					}
-					goto l208
-				l207:
-					position, tokenIndex, depth = position207, tokenIndex207, depth207
+					goto l238
+				l237:
+					position, tokenIndex, depth = position237, tokenIndex237, depth237
 				}
-			l208:
+			l238:
 				if !_rules[ruleValue]() {
-					goto l202
+					goto l232
 				}
 				{
-					add(ruleAction21, position)
+					add(ruleAction24, position)
 				}
 				{
-					position210, tokenIndex210, depth210 := position, tokenIndex, depth
+					position240, tokenIndex240, depth240 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l210
+						goto l240
 					}
-					goto l211
-				l210:
-					position, tokenIndex, depth = position210, tokenIndex210, depth210
+					goto l241
+				l240:
+					position, tokenIndex, depth = position240, tokenIndex240, depth240
 				}
-			l211:
+			l241:
 				{
-					add(ruleAction22, position)
+					add(ruleAction25, position)
 				}
 				depth--
-				add(ruleOrderedDocElem, position203)
+				add(ruleOrderedDocElem, position233)
 			}
 			return true
-		l202:
-			position, tokenIndex, depth = position202, tokenIndex202, depth202
+		l232:
+			position, tokenIndex, depth = position232, tokenIndex232, depth232
 			return false
 		},
-		/* 23 exceptionField <- <('e' 'x' 'c' 'e' 'p' 't' 'i' 'o' 'n' ':' Action23 <(&(. !('c' 'o' 'd' 'e' ':')) .)+> S? Action24)> */
+		/* 25 exceptionField <- <('e' 'x' 'c' 'e' 'p' 't' 'i' 'o' 'n' ':' Action26 <(&(. !('c' 'o' 'd' 'e' ':')) .)+> S? Action27)> */
 		nil,
-		/* 24 LineValue <- <((Doc / Numeric / PartialDoc) S?)> */
+		/* 26 LineValue <- <((Doc / ((&('{') PartialDoc) | (&('"') String) | (&('-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | 'I' | 'N' | 'i' | 'n') Numeric))) S?)> */
 		func() bool {
-			position214, tokenIndex214, depth214 := position, tokenIndex, depth
+			position244, tokenIndex244, depth244 := position, tokenIndex, depth
 			{
-				position215 := position
+				position245 := position
 				depth++
 				{
-					position216, tokenIndex216, depth216 := position, tokenIndex, depth
+					position246, tokenIndex246, depth246 := position, tokenIndex, depth
 					if !_rules[ruleDoc]() {
-						goto l217
-					}
-					goto l216
-				l217:
-					position, tokenIndex, depth = position216, tokenIndex216, depth216
-					if !_rules[ruleNumeric]() {
-						goto l218
+						goto l247
 					}
-					goto l216
-				l218:
-					position, tokenIndex, depth = position216, tokenIndex216, depth216
+					goto l246
+				l247:
+					position, tokenIndex, depth = position246, tokenIndex246, depth246
 					{
-						position219 := position
-						depth++
-						{
-							position220 := position
-							depth++
+						switch buffer[position] {
+						case '{':
 							{
-								position221 := position
+								position249 := position
 								depth++
-								if buffer[position] != rune('{') {
-									goto l214
-								}
-								position++
-								{
-									position224, tokenIndex224, depth224 := position, tokenIndex, depth
-									if buffer[position] != rune('}') {
-										goto l224
-									}
-									position++
-									goto l214
-								l224:
-									position, tokenIndex, depth = position224, tokenIndex224, depth224
-								}
-								if !matchDot() {
-									goto l214
-								}
-							l222:
 								{
-									position223, tokenIndex223, depth223 := position, tokenIndex, depth
+									position250 := position
+									depth++
 									{
-										position225, tokenIndex225, depth225 := position, tokenIndex, depth
-										if buffer[position] != rune('}') {
-											goto l225
+										position251 := position
+										depth++
+										if buffer[position] != rune('{') {
+											goto l244
 										}
 										position++
-										goto l223
-									l225:
-										position, tokenIndex, depth = position225, tokenIndex225, depth225
-									}
-									if !matchDot() {
-										goto l223
-									}
-									goto l222
-								l223:
-									position, tokenIndex, depth = position223, tokenIndex223, depth223
-								}
-								if buffer[position] != rune('}') {
-									goto l214
-								}
-								position++
-							l226:
-								{
-									position227, tokenIndex227, depth227 := position, tokenIndex, depth
-									{
-										position228 := position
-										depth++
 										{
-											position229, tokenIndex229, depth229 := position, tokenIndex, depth
+											position254, tokenIndex254, depth254 := position, tokenIndex, depth
+											if buffer[position] != rune('}') {
+												goto l254
+											}
+											position++
+											goto l244
+										l254:
+											position, tokenIndex, depth = position254, tokenIndex254, depth254
+										}
+										if !matchDot() {
+											goto l244
+										}
+									l252:
+										{
+											position253, tokenIndex253, depth253 := position, tokenIndex, depth
+											{
+												position255, tokenIndex255, depth255 := position, tokenIndex, depth
+												if buffer[position] != rune('}') {
+													goto l255
+												}
+												position++
+												goto l253
+											l255:
+												position, tokenIndex, depth = position255, tokenIndex255, depth255
+											}
 											if !matchDot() {
-												goto l227
+												goto l253
 											}
+											goto l252
+										l253:
+											position, tokenIndex, depth = position253, tokenIndex253, depth253
+										}
+										if buffer[position] != rune('}') {
+											goto l244
+										}
+										position++
+									l256:
+										{
+											position257, tokenIndex257, depth257 := position, tokenIndex, depth
 											{
-												position230, tokenIndex230, depth230 := position, tokenIndex, depth
+												position258 := position
+												depth++
 												{
-													position231 := position
-													depth++
+													position259, tokenIndex259, depth259 := position, tokenIndex, depth
+													if !matchDot() {
+														goto l257
+													}
 													{
-														position232, tokenIndex232, depth232 := position, tokenIndex, depth
-														if buffer[position] != rune('n') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('i') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('n') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('s') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('t') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l233
-														}
-														position++
-														if buffer[position] != rune('d') {
-															goto l233
-														}
-														position++
-														goto l232
-													l233:
-														position, tokenIndex, depth = position232, tokenIndex232, depth232
+														position260, tokenIndex260, depth260 := position, tokenIndex, depth
 														{
-															switch buffer[position] {
-															case 'n':
+															position261 := position
+															depth++
+															{
+																position262, tokenIndex262, depth262 := position, tokenIndex, depth
 																if buffer[position] != rune('n') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('t') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('o') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('r') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('e') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('t') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('u') {
-																	goto l230
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('r') {
-																	goto l230
+																if buffer[position] != rune('i') {
+																	goto l263
 																}
 																position++
 																if buffer[position] != rune('n') {
-																	goto l230
-																}
-																position++
-																break
-															case 'c':
-																if buffer[position] != rune('c') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('u') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('r') {
-																	goto l230
+																	goto l263
 																}
 																position++
 																if buffer[position] != rune('s') {
-																	goto l230
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('o') {
-																	goto l230
+																if buffer[position] != rune('e') {
+																	goto l263
 																}
 																position++
 																if buffer[position] != rune('r') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('i') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('d') {
-																	goto l230
-																}
-																position++
-																break
-															default:
-																if buffer[position] != rune('p') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('l') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('a') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('n') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('S') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('u') {
-																	goto l230
-																}
-																position++
-																if buffer[position] != rune('m') {
-																	goto l230
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('m') {
-																	goto l230
+																if buffer[position] != rune('t') {
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('a') {
-																	goto l230
+																if buffer[position] != rune('e') {
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('r') {
-																	goto l230
+																if buffer[position] != rune('d') {
+																	goto l263
 																}
 																position++
-																if buffer[position] != rune('y') {
-																	goto l230
+																goto l262
+															l263:
+																position, tokenIndex, depth = position262, tokenIndex262, depth262
+																{
+																	switch buffer[position] {
+																	case 'n':
+																		if buffer[position] != rune('n') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('t') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('o') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('e') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('t') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l260
+																		}
+																		position++
+																		break
+																	case 'c':
+																		if buffer[position] != rune('c') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('s') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('o') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('i') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l260
+																		}
+																		position++
+																		break
+																	default:
+																		if buffer[position] != rune('p') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('l') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('a') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('S') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('m') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('m') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('a') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l260
+																		}
+																		position++
+																		if buffer[position] != rune('y') {
+																			goto l260
+																		}
+																		position++
+																		break
+																	}
 																}
-																position++
-																break
+
 															}
+														l262:
+															depth--
+															add(ruleknownField, position261)
 														}
-
+														goto l257
+													l260:
+														position, tokenIndex, depth = position260, tokenIndex260, depth260
 													}
-												l232:
-													depth--
-													add(ruleknownField, position231)
+													position, tokenIndex, depth = position259, tokenIndex259, depth259
+												}
+												if !matchDot() {
+													goto l257
 												}
-												goto l227
-											l230:
-												position, tokenIndex, depth = position230, tokenIndex230, depth230
+												depth--
+												add(rulepartialDocExtra, position258)
 											}
-											position, tokenIndex, depth = position229, tokenIndex229, depth229
-										}
-										if !matchDot() {
-											goto l227
+											goto l256
+										l257:
+											position, tokenIndex, depth = position257, tokenIndex257, depth257
 										}
 										depth--
-										add(rulepartialDocExtra, position228)
+										add(rulepartialDoc, position251)
 									}
-									goto l226
-								l227:
-									position, tokenIndex, depth = position227, tokenIndex227, depth227
+									depth--
+									add(rulePegText, position250)
+								}
+								{
+									add(ruleAction28, position)
 								}
 								depth--
-								add(rulepartialDoc, position221)
+								add(rulePartialDoc, position249)
 							}
-							depth--
-							add(rulePegText, position220)
-						}
-						{
-							add(ruleAction25, position)
+							break
+						case '"':
+							if !_rules[ruleString]() {
+								goto l244
+							}
+							break
+						default:
+							if !_rules[ruleNumeric]() {
+								goto l244
+							}
+							break
 						}
-						depth--
-						add(rulePartialDoc, position219)
 					}
+
 				}
-			l216:
+			l246:
 				{
-					position236, tokenIndex236, depth236 := position, tokenIndex, depth
+					position266, tokenIndex266, depth266 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l236
+						goto l266
 					}
-					goto l237
-				l236:
-					position, tokenIndex, depth = position236, tokenIndex236, depth236
+					goto l267
+				l266:
+					position, tokenIndex, depth = position266, tokenIndex266, depth266
 				}
-			l237:
+			l267:
 				depth--
-				add(ruleLineValue, position215)
+				add(ruleLineValue, position245)
 			}
 			return true
-		l214:
-			position, tokenIndex, depth = position214, tokenIndex214, depth214
+		l244:
+			position, tokenIndex, depth = position244, tokenIndex244, depth244
 			return false
 		},
-		/* 25 PartialDoc <- <(<partialDoc> Action25)> */
+		/* 27 PartialDoc <- <(<partialDoc> Action28)> */
 		nil,
-		/* 26 partialDoc <- <('{' (!'}' .)+ '}' partialDocExtra*)> */
+		/* 28 partialDoc <- <('{' (!'}' .)+ '}' partialDocExtra*)> */
 		nil,
-		/* 27 partialDocExtra <- <(&(. !knownField) .)> */
+		/* 29 partialDocExtra <- <(&(. !knownField) .)> */
 		nil,
-		/* 28 knownField <- <(('n' 'i' 'n' 's' 'e' 'r' 't' 'e' 'd') / ((&('n') ('n' 't' 'o' 'r' 'e' 't' 'u' 'r' 'n')) | (&('c') ('c' 'u' 'r' 's' 'o' 'r' 'i' 'd')) | (&('p') ('p' 'l' 'a' 'n' 'S' 'u' 'm' 'm' 'a' 'r' 'y'))))> */
+		/* 30 knownField <- <(('n' 'i' 'n' 's' 'e' 'r' 't' 'e' 'd') / ((&('n') ('n' 't' 'o' 'r' 'e' 't' 'u' 'r' 'n')) | (&('c') ('c' 'u' 'r' 's' 'o' 'r' 'i' 'd')) | (&('p') ('p' 'l' 'a' 'n' 'S' 'u' 'm' 'm' 'a' 'r' 'y'))))> */
 		nil,
-		/* 29 timestamp24 <- <(<(date ' ' time)> Action26)> */
+		/* 31 timestamp24 <- <(<(date ' ' time)> Action29)> */
 		nil,
-		/* 30 timestamp26 <- <(<datetime26> Action27)> */
+		/* 32 timestamp26 <- <(<datetime26> Action30)> */
 		nil,
-		/* 31 datetime26 <- <(digit4 '-' digit2 '-' digit2 'T' time tz?)> */
+		/* 33 datetime26 <- <(digit4 '-' digit2 '-' digit2 'T' time tz?)> */
 		nil,
-		/* 32 digit4 <- <([0-9] [0-9] [0-9] [0-9])> */
+		/* 34 digit4 <- <([0-9] [0-9] [0-9] [0-9])> */
 		nil,
-		/* 33 digit2 <- <([0-9] [0-9])> */
+		/* 35 digit2 <- <([0-9] [0-9])> */
 		func() bool {
-			position246, tokenIndex246, depth246 := position, tokenIndex, depth
+			position276, tokenIndex276, depth276 := position, tokenIndex, depth
 			{
-				position247 := position
+				position277 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l246
+					goto l276
 				}
 				position++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l246
+					goto l276
 				}
 				position++
 				depth--
-				add(ruledigit2, position247)
+				add(ruledigit2, position277)
 			}
 			return true
-		l246:
-			position, tokenIndex, depth = position246, tokenIndex246, depth246
+		l276:
+			position, tokenIndex, depth = position276, tokenIndex276, depth276
 			return false
 		},
-		/* 34 date <- <(day ' ' month ' '+ dayNum)> */
+		/* 36 date <- <(day ' ' month ' '+ dayNum)> */
 		nil,
-		/* 35 tz <- <('+' [0-9]+)> */
+		/* 37 tz <- <('+' [0-9]+)> */
 		nil,
-		/* 36 time <- <(hour ':' minute ':' second '.' millisecond)> */
+		/* 38 time <- <(hour ':' minute ':' second '.' millisecond)> */
 		func() bool {
-			position250, tokenIndex250, depth250 := position, tokenIndex, depth
+			position280, tokenIndex280, depth280 := position, tokenIndex, depth
 			{
-				position251 := position
+				position281 := position
 				depth++
 				{
-					position252 := position
+					position282 := position
 					depth++
 					if !_rules[ruledigit2]() {
-						goto l250
+						goto l280
 					}
 					depth--
-					add(rulehour, position252)
+					add(rulehour, position282)
 				}
 				if buffer[position] != rune(':') {
-					goto l250
+					goto l280
 				}
 				position++
 				{
-					position253 := position
+					position283 := position
 					depth++
 					if !_rules[ruledigit2]() {
-						goto l250
+						goto l280
 					}
 					depth--
-					add(ruleminute, position253)
+					add(ruleminute, position283)
 				}
 				if buffer[position] != rune(':') {
-					goto l250
+					goto l280
 				}
 				position++
 				{
-					position254 := position
+					position284 := position
 					depth++
 					if !_rules[ruledigit2]() {
-						goto l250
+						goto l280
 					}
 					depth--
-					add(rulesecond, position254)
+					add(rulesecond, position284)
 				}
 				if buffer[position] != rune('.') {
-					goto l250
+					goto l280
 				}
 				position++
 				{
-					position255 := position
+					position285 := position
 					depth++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l250
+						goto l280
 					}
 					position++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l250
+						goto l280
 					}
 					position++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l250
+						goto l280
 					}
 					position++
 					depth--
-					add(rulemillisecond, position255)
+					add(rulemillisecond, position285)
 				}
 				depth--
-				add(ruletime, position251)
+				add(ruletime, position281)
 			}
 			return true
-		l250:
-			position, tokenIndex, depth = position250, tokenIndex250, depth250
+		l280:
+			position, tokenIndex, depth = position280, tokenIndex280, depth280
 			return false
 		},
-		/* 37 day <- <([A-Z] [a-z] [a-z])> */
+		/* 39 day <- <([A-Z] [a-z] [a-z])> */
 		nil,
-		/* 38 month <- <([A-Z] [a-z] [a-z])> */
+		/* 40 month <- <([A-Z] [a-z] [a-z])> */
 		nil,
-		/* 39 dayNum <- <([0-9] [0-9]?)> */
+		/* 41 dayNum <- <([0-9] [0-9]?)> */
 		nil,
-		/* 40 hour <- <digit2> */
+		/* 42 hour <- <digit2> */
 		nil,
-		/* 41 minute <- <digit2> */
+		/* 43 minute <- <digit2> */
 		nil,
-		/* 42 second <- <digit2> */
+		/* 44 second <- <digit2> */
 		nil,
-		/* 43 millisecond <- <([0-9] [0-9] [0-9])> */
+		/* 45 millisecond <- <([0-9] [0-9] [0-9])> */
 		nil,
-		/* 44 letterOrDigit <- <((&('$' | '_') ('_' / '$')) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		/* 46 letterOrDigit <- <((&('$' | '_') ('_' / '$')) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
 		nil,
-		/* 45 nsChar <- <((&('$') '$') | (&(':') ':') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '\\' | ']' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [A-z]))> */
+		/* 47 fieldChar <- <((&('$' | '*' | '.' | '_') ((&('*') '*') | (&('.') '.') | (&('$') '$') | (&('_') '_'))) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		func() bool {
+			position294, tokenIndex294, depth294 := position, tokenIndex, depth
+			{
+				position295 := position
+				depth++
+				{
+					switch buffer[position] {
+					case '$', '*', '.', '_':
+						{
+							switch buffer[position] {
+							case '*':
+								if buffer[position] != rune('*') {
+									goto l294
+								}
+								position++
+								break
+							case '.':
+								if buffer[position] != rune('.') {
+									goto l294
+								}
+								position++
+								break
+							case '$':
+								if buffer[position] != rune('$') {
+									goto l294
+								}
+								position++
+								break
+							default:
+								if buffer[position] != rune('_') {
+									goto l294
+								}
+								position++
+								break
+							}
+						}
+
+						break
+					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l294
+						}
+						position++
+						break
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l294
+						}
+						position++
+						break
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l294
+						}
+						position++
+						break
+					}
+				}
+
+				depth--
+				add(rulefieldChar, position295)
+			}
+			return true
+		l294:
+			position, tokenIndex, depth = position294, tokenIndex294, depth294
+			return false
+		},
+		/* 48 nsChar <- <((&('$') '$') | (&(':') ':') | (&('.') '.') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '\\' | ']' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [A-z]))> */
 		nil,
-		/* 46 extra <- <(<.+> Action28)> */
+		/* 49 extra <- <(<.+> Action31)> */
 		nil,
-		/* 47 S <- <' '+> */
+		/* 50 S <- <' '+> */
 		func() bool {
-			position266, tokenIndex266, depth266 := position, tokenIndex, depth
+			position300, tokenIndex300, depth300 := position, tokenIndex, depth
 			{
-				position267 := position
+				position301 := position
 				depth++
 				if buffer[position] != rune(' ') {
-					goto l266
+					goto l300
 				}
 				position++
-			l268:
+			l302:
 				{
-					position269, tokenIndex269, depth269 := position, tokenIndex, depth
+					position303, tokenIndex303, depth303 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l269
+						goto l303
 					}
 					position++
-					goto l268
-				l269:
-					position, tokenIndex, depth = position269, tokenIndex269, depth269
+					goto l302
+				l303:
+					position, tokenIndex, depth = position303, tokenIndex303, depth303
 				}
 				depth--
-				add(ruleS, position267)
+				add(ruleS, position301)
 			}
 			return true
-		l266:
-			position, tokenIndex, depth = position266, tokenIndex266, depth266
+		l300:
+			position, tokenIndex, depth = position300, tokenIndex300, depth300
 			return false
 		},
-		/* 48 Doc <- <('{' Action29 DocElements? '}' Action30)> */
+		/* 51 Doc <- <('{' Action32 DocElements? '}' Action33)> */
 		func() bool {
-			position270, tokenIndex270, depth270 := position, tokenIndex, depth
+			position304, tokenIndex304, depth304 := position, tokenIndex, depth
 			{
-				position271 := position
+				position305 := position
 				depth++
 				if buffer[position] != rune('{') {
-					goto l270
+					goto l304
 				}
 				position++
 				{
-					add(ruleAction29, position)
+					add(ruleAction32, position)
 				}
 				{
-					position273, tokenIndex273, depth273 := position, tokenIndex, depth
+					position307, tokenIndex307, depth307 := position, tokenIndex, depth
 					{
-						position275 := position
+						position309 := position
 						depth++
 						if !_rules[ruleDocElem]() {
-							goto l273
+							goto l307
 						}
-					l276:
+					l310:
 						{
-							position277, tokenIndex277, depth277 := position, tokenIndex, depth
+							position311, tokenIndex311, depth311 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l277
+								goto l311
 							}
 							position++
 							if !_rules[ruleDocElem]() {
-								goto l277
+								goto l311
 							}
-							goto l276
-						l277:
-							position, tokenIndex, depth = position277, tokenIndex277, depth277
+							goto l310
+						l311:
+							position, tokenIndex, depth = position311, tokenIndex311, depth311
 						}
 						depth--
-						add(ruleDocElements, position275)
+						add(ruleDocElements, position309)
 					}
-					goto l274
-				l273:
-					position, tokenIndex, depth = position273, tokenIndex273, depth273
+					goto l308
+				l307:
+					position, tokenIndex, depth = position307, tokenIndex307, depth307
 				}
-			l274:
+			l308:
 				if buffer[position] != rune('}') {
-					goto l270
+					goto l304
 				}
 				position++
 				{
-					add(ruleAction30, position)
+					add(ruleAction33, position)
 				}
 				depth--
-				add(ruleDoc, position271)
+				add(ruleDoc, position305)
 			}
 			return true
-		l270:
-			position, tokenIndex, depth = position270, tokenIndex270, depth270
+		l304:
+			position, tokenIndex, depth = position304, tokenIndex304, depth304
 			return false
 		},
-		/* 49 DocElements <- <(DocElem (',' DocElem)*)> */
+		/* 52 DocElements <- <(DocElem (',' DocElem)*)> */
 		nil,
-		/* 50 DocElem <- <(S? Field S? Value S? Action31)> */
+		/* 53 DocElem <- <(S? Field S? Value S? Action34)> */
 		func() bool {
-			position280, tokenIndex280, depth280 := position, tokenIndex, depth
+			position314, tokenIndex314, depth314 := position, tokenIndex, depth
 			{
-				position281 := position
+				position315 := position
 				depth++
 				{
-					position282, tokenIndex282, depth282 := position, tokenIndex, depth
+					position316, tokenIndex316, depth316 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l282
+						goto l316
 					}
-					goto l283
-				l282:
-					position, tokenIndex, depth = position282, tokenIndex282, depth282
+					goto l317
+				l316:
+					position, tokenIndex, depth = position316, tokenIndex316, depth316
 				}
-			l283:
+			l317:
 				if !_rules[ruleField]() {
-					goto l280
+					goto l314
 				}
 				{
-					position284, tokenIndex284, depth284 := position, tokenIndex, depth
+					position318, tokenIndex318, depth318 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l284
+						goto l318
 					}
-					goto l285
-				l284:
-					position, tokenIndex, depth = position284, tokenIndex284, depth284
+					goto l319
+				l318:
+					position, tokenIndex, depth = position318, tokenIndex318, depth318
 				}
-			l285:
+			l319:
 				if !_rules[ruleValue]() {
-					goto l280
+					goto l314
 				}
 				{
-					position286, tokenIndex286, depth286 := position, tokenIndex, depth
+					position320, tokenIndex320, depth320 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l286
+						goto l320
 					}
-					goto l287
-				l286:
-					position, tokenIndex, depth = position286, tokenIndex286, depth286
+					goto l321
+				l320:
+					position, tokenIndex, depth = position320, tokenIndex320, depth320
 				}
-			l287:
+			l321:
 				{
-					add(ruleAction31, position)
+					add(ruleAction34, position)
 				}
 				depth--
-				add(ruleDocElem, position281)
+				add(ruleDocElem, position315)
 			}
 			return true
-		l280:
-			position, tokenIndex, depth = position280, tokenIndex280, depth280
+		l314:
+			position, tokenIndex, depth = position314, tokenIndex314, depth314
 			return false
 		},
-		/* 51 List <- <('[' Action32 ListElements? ']' Action33)> */
+		/* 54 List <- <('[' Action35 ListElements? ']' Action36)> */
 		nil,
-		/* 52 ListElements <- <(ListElem (',' ListElem)*)> */
+		/* 55 ListElements <- <(ListElem (',' ListElem)*)> */
 		nil,
-		/* 53 ListElem <- <(S? Value S? Action34)> */
+		/* 56 ListElem <- <(S? Value S? Action37)> */
 		func() bool {
-			position291, tokenIndex291, depth291 := position, tokenIndex, depth
+			position325, tokenIndex325, depth325 := position, tokenIndex, depth
 			{
-				position292 := position
+				position326 := position
 				depth++
 				{
-					position293, tokenIndex293, depth293 := position, tokenIndex, depth
+					position327, tokenIndex327, depth327 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l293
+						goto l327
 					}
-					goto l294
-				l293:
-					position, tokenIndex, depth = position293, tokenIndex293, depth293
+					goto l328
+				l327:
+					position, tokenIndex, depth = position327, tokenIndex327, depth327
 				}
-			l294:
+			l328:
 				if !_rules[ruleValue]() {
-					goto l291
+					goto l325
 				}
 				{
-					position295, tokenIndex295, depth295 := position, tokenIndex, depth
+					position329, tokenIndex329, depth329 := position, tokenIndex, depth
 					if !_rules[ruleS]() {
-						goto l295
+						goto l329
 					}
-					goto l296
-				l295:
-					position, tokenIndex, depth = position295, tokenIndex295, depth295
+					goto l330
+				l329:
+					position, tokenIndex, depth = position329, tokenIndex329, depth329
 				}
-			l296:
+			l330:
 				{
-					add(ruleAction34, position)
+					add(ruleAction37, position)
 				}
 				depth--
-				add(ruleListElem, position292)
+				add(ruleListElem, position326)
 			}
 			return true
-		l291:
-			position, tokenIndex, depth = position291, tokenIndex291, depth291
+		l325:
+			position, tokenIndex, depth = position325, tokenIndex325, depth325
 			return false
 		},
-		/* 54 Field <- <(<fieldChar+> ':' Action35)> */
+		/* 57 Field <- <(('"' <stringChar*> '"' ':' Action38) / (<fieldName> ':' Action39))> */
 		func() bool {
-			position298, tokenIndex298, depth298 := position, tokenIndex, depth
+			position332, tokenIndex332, depth332 := position, tokenIndex, depth
 			{
-				position299 := position
+				position333 := position
 				depth++
 				{
-					position300 := position
-					depth++
-					if !_rules[rulefieldChar]() {
-						goto l298
+					position334, tokenIndex334, depth334 := position, tokenIndex, depth
+					if buffer[position] != rune('"') {
+						goto l335
 					}
-				l301:
+					position++
 					{
-						position302, tokenIndex302, depth302 := position, tokenIndex, depth
-						if !_rules[rulefieldChar]() {
-							goto l302
+						position336 := position
+						depth++
+					l337:
+						{
+							position338, tokenIndex338, depth338 := position, tokenIndex, depth
+							if !_rules[rulestringChar]() {
+								goto l338
+							}
+							goto l337
+						l338:
+							position, tokenIndex, depth = position338, tokenIndex338, depth338
 						}
-						goto l301
-					l302:
-						position, tokenIndex, depth = position302, tokenIndex302, depth302
+						depth--
+						add(rulePegText, position336)
+					}
+					if buffer[position] != rune('"') {
+						goto l335
+					}
+					position++
+					if buffer[position] != rune(':') {
+						goto l335
+					}
+					position++
+					{
+						add(ruleAction38, position)
+					}
+					goto l334
+				l335:
+					position, tokenIndex, depth = position334, tokenIndex334, depth334
+					{
+						position340 := position
+						depth++
+						{
+							position341 := position
+							depth++
+							if !_rules[rulefieldNameChar]() {
+								goto l332
+							}
+						l342:
+							{
+								position343, tokenIndex343, depth343 := position, tokenIndex, depth
+								if !_rules[rulefieldNameChar]() {
+									goto l343
+								}
+								goto l342
+							l343:
+								position, tokenIndex, depth = position343, tokenIndex343, depth343
+							}
+						l344:
+							{
+								position345, tokenIndex345, depth345 := position, tokenIndex, depth
+								if buffer[position] != rune(' ') {
+									goto l345
+								}
+								position++
+							l346:
+								{
+									position347, tokenIndex347, depth347 := position, tokenIndex, depth
+									if buffer[position] != rune(' ') {
+										goto l347
+									}
+									position++
+									goto l346
+								l347:
+									position, tokenIndex, depth = position347, tokenIndex347, depth347
+								}
+								if !_rules[rulefieldNameChar]() {
+									goto l345
+								}
+							l348:
+								{
+									position349, tokenIndex349, depth349 := position, tokenIndex, depth
+									if !_rules[rulefieldNameChar]() {
+										goto l349
+									}
+									goto l348
+								l349:
+									position, tokenIndex, depth = position349, tokenIndex349, depth349
+								}
+								goto l344
+							l345:
+								position, tokenIndex, depth = position345, tokenIndex345, depth345
+							}
+							depth--
+							add(rulefieldName, position341)
+						}
+						depth--
+						add(rulePegText, position340)
+					}
+					if buffer[position] != rune(':') {
+						goto l332
+					}
+					position++
+					{
+						add(ruleAction39, position)
 					}
-					depth--
-					add(rulePegText, position300)
-				}
-				if buffer[position] != rune(':') {
-					goto l298
-				}
-				position++
-				{
-					add(ruleAction35, position)
 				}
+			l334:
 				depth--
-				add(ruleField, position299)
+				add(ruleField, position333)
 			}
 			return true
-		l298:
-			position, tokenIndex, depth = position298, tokenIndex298, depth298
+		l332:
+			position, tokenIndex, depth = position332, tokenIndex332, depth332
 			return false
 		},
-		/* 55 Value <- <(Null / MinKey / ((&('M') MaxKey) | (&('u') Undefined) | (&('N') NumberLong) | (&('/') Regex) | (&('T') TimestampVal) | (&('B') BinData) | (&('D' | 'n') Date) | (&('O') ObjectID) | (&('"') String) | (&('f' | 't') Boolean) | (&('[') List) | (&('{') Doc) | (&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') Numeric)))> */
+		/* 58 Value <- <(Numeric / Boolean / Null / ObjectID / Date / BinData / UUID / HexData / TimestampVal / NumberLong / NumberInt / NumberDecimal / Code / CodeWScope / DBRef / DBPointer / Symbol / Constructor / MinKey / ((&('M') MaxKey) | (&('u') Undefined) | (&('f') JavaScript) | (&('/') Regex) | (&('"') String) | (&('[') List) | (&('{') Doc)))> */
 		func() bool {
-			position304, tokenIndex304, depth304 := position, tokenIndex, depth
+			position351, tokenIndex351, depth351 := position, tokenIndex, depth
 			{
-				position305 := position
+				position352 := position
 				depth++
 				{
-					position306, tokenIndex306, depth306 := position, tokenIndex, depth
+					position353, tokenIndex353, depth353 := position, tokenIndex, depth
+					if !_rules[ruleNumeric]() {
+						goto l354
+					}
+					goto l353
+				l354:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					{
-						position308 := position
+						position356 := position
 						depth++
-						if buffer[position] != rune('n') {
-							goto l307
+						{
+							position357, tokenIndex357, depth357 := position, tokenIndex, depth
+							{
+								position359 := position
+								depth++
+								if buffer[position] != rune('t') {
+									goto l358
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l358
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l358
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l358
+								}
+								position++
+								{
+									add(ruleAction43, position)
+								}
+								depth--
+								add(ruleTrue, position359)
+							}
+							goto l357
+						l358:
+							position, tokenIndex, depth = position357, tokenIndex357, depth357
+							{
+								position361 := position
+								depth++
+								if buffer[position] != rune('f') {
+									goto l355
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l355
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l355
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l355
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l355
+								}
+								position++
+								{
+									add(ruleAction44, position)
+								}
+								depth--
+								add(ruleFalse, position361)
+							}
+						}
+					l357:
+						depth--
+						add(ruleBoolean, position356)
+					}
+					goto l353
+				l355:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position364 := position
+						depth++
+						if buffer[position] != rune('n') {
+							goto l363
 						}
 						position++
 						if buffer[position] != rune('u') {
-							goto l307
+							goto l363
 						}
 						position++
 						if buffer[position] != rune('l') {
-							goto l307
+							goto l363
 						}
 						position++
 						if buffer[position] != rune('l') {
-							goto l307
+							goto l363
 						}
 						position++
 						{
-							add(ruleAction38, position)
+							add(ruleAction42, position)
 						}
 						depth--
-						add(ruleNull, position308)
+						add(ruleNull, position364)
 					}
-					goto l306
-				l307:
-					position, tokenIndex, depth = position306, tokenIndex306, depth306
+					goto l353
+				l363:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					{
-						position311 := position
+						position367 := position
 						depth++
-						if buffer[position] != rune('M') {
-							goto l310
+						if buffer[position] != rune('O') {
+							goto l366
 						}
 						position++
-						if buffer[position] != rune('i') {
-							goto l310
+						if buffer[position] != rune('b') {
+							goto l366
 						}
 						position++
-						if buffer[position] != rune('n') {
-							goto l310
+						if buffer[position] != rune('j') {
+							goto l366
 						}
 						position++
-						if buffer[position] != rune('K') {
-							goto l310
+						if buffer[position] != rune('e') {
+							goto l366
 						}
 						position++
-						if buffer[position] != rune('e') {
-							goto l310
+						if buffer[position] != rune('c') {
+							goto l366
 						}
 						position++
-						if buffer[position] != rune('y') {
-							goto l310
+						if buffer[position] != rune('t') {
+							goto l366
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l366
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l366
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l366
 						}
 						position++
 						{
-							add(ruleAction48, position)
+							position368, tokenIndex368, depth368 := position, tokenIndex, depth
+							if buffer[position] != rune('\'') {
+								goto l369
+							}
+							position++
+							goto l368
+						l369:
+							position, tokenIndex, depth = position368, tokenIndex368, depth368
+							if buffer[position] != rune('"') {
+								goto l366
+							}
+							position++
+						}
+					l368:
+						{
+							position370 := position
+							depth++
+						l371:
+							{
+								position372, tokenIndex372, depth372 := position, tokenIndex, depth
+								if !_rules[rulehexChar]() {
+									goto l372
+								}
+								goto l371
+							l372:
+								position, tokenIndex, depth = position372, tokenIndex372, depth372
+							}
+							depth--
+							add(rulePegText, position370)
+						}
+						{
+							position373, tokenIndex373, depth373 := position, tokenIndex, depth
+							if buffer[position] != rune('\'') {
+								goto l374
+							}
+							position++
+							goto l373
+						l374:
+							position, tokenIndex, depth = position373, tokenIndex373, depth373
+							if buffer[position] != rune('"') {
+								goto l366
+							}
+							position++
+						}
+					l373:
+						if buffer[position] != rune(')') {
+							goto l366
+						}
+						position++
+						{
+							add(ruleAction47, position)
 						}
 						depth--
-						add(ruleMinKey, position311)
+						add(ruleObjectID, position367)
 					}
-					goto l306
-				l310:
-					position, tokenIndex, depth = position306, tokenIndex306, depth306
+					goto l353
+				l366:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					{
-						switch buffer[position] {
-						case 'M':
+						position377 := position
+						depth++
+						{
+							position378, tokenIndex378, depth378 := position, tokenIndex, depth
 							{
-								position314 := position
+								position380 := position
 								depth++
-								if buffer[position] != rune('M') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('a') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('x') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('K') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('y') {
-									goto l304
-								}
-								position++
 								{
-									add(ruleAction49, position)
+									position381, tokenIndex381, depth381 := position, tokenIndex, depth
+									if buffer[position] != rune('n') {
+										goto l381
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l381
+									}
+									position++
+									if buffer[position] != rune('w') {
+										goto l381
+									}
+									position++
+									if buffer[position] != rune(' ') {
+										goto l381
+									}
+									position++
+									goto l382
+								l381:
+									position, tokenIndex, depth = position381, tokenIndex381, depth381
 								}
-								depth--
-								add(ruleMaxKey, position314)
-							}
-							break
-						case 'u':
-							{
-								position316 := position
-								depth++
-								if buffer[position] != rune('u') {
-									goto l304
+							l382:
+								if buffer[position] != rune('D') {
+									goto l379
 								}
 								position++
-								if buffer[position] != rune('n') {
-									goto l304
+								if buffer[position] != rune('a') {
+									goto l379
 								}
 								position++
-								if buffer[position] != rune('d') {
-									goto l304
+								if buffer[position] != rune('t') {
+									goto l379
 								}
 								position++
 								if buffer[position] != rune('e') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('f') {
-									goto l304
+									goto l379
 								}
 								position++
-								if buffer[position] != rune('i') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l304
+								if buffer[position] != rune('(') {
+									goto l379
 								}
 								position++
-								if buffer[position] != rune('e') {
-									goto l304
+								{
+									position383 := position
+									depth++
+									{
+										position384, tokenIndex384, depth384 := position, tokenIndex, depth
+										if buffer[position] != rune('-') {
+											goto l384
+										}
+										position++
+										goto l385
+									l384:
+										position, tokenIndex, depth = position384, tokenIndex384, depth384
+									}
+								l385:
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l379
+									}
+									position++
+								l386:
+									{
+										position387, tokenIndex387, depth387 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l387
+										}
+										position++
+										goto l386
+									l387:
+										position, tokenIndex, depth = position387, tokenIndex387, depth387
+									}
+									depth--
+									add(rulePegText, position383)
 								}
-								position++
-								if buffer[position] != rune('d') {
-									goto l304
+								if buffer[position] != rune(')') {
+									goto l379
 								}
 								position++
 								{
-									add(ruleAction50, position)
+									add(ruleAction45, position)
 								}
 								depth--
-								add(ruleUndefined, position316)
+								add(ruledateMillis, position380)
 							}
-							break
-						case 'N':
+							goto l378
+						l379:
+							position, tokenIndex, depth = position378, tokenIndex378, depth378
 							{
-								position318 := position
+								position389 := position
 								depth++
-								if buffer[position] != rune('N') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('u') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('m') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('b') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('r') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('L') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('o') {
-									goto l304
+								{
+									position390, tokenIndex390, depth390 := position, tokenIndex, depth
+									if buffer[position] != rune('n') {
+										goto l390
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l390
+									}
+									position++
+									if buffer[position] != rune('w') {
+										goto l390
+									}
+									position++
+									if buffer[position] != rune(' ') {
+										goto l390
+									}
+									position++
+									goto l391
+								l390:
+									position, tokenIndex, depth = position390, tokenIndex390, depth390
 								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l304
+							l391:
+								{
+									position392, tokenIndex392, depth392 := position, tokenIndex, depth
+									if buffer[position] != rune('I') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('S') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('O') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('D') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l393
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l393
+									}
+									position++
+									goto l392
+								l393:
+									position, tokenIndex, depth = position392, tokenIndex392, depth392
+									if buffer[position] != rune('D') {
+										goto l376
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l376
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l376
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l376
+									}
+									position++
 								}
-								position++
-								if buffer[position] != rune('g') {
-									goto l304
+							l392:
+								if buffer[position] != rune('(') {
+									goto l376
 								}
 								position++
-								if buffer[position] != rune('(') {
-									goto l304
+								if buffer[position] != rune('"') {
+									goto l376
 								}
 								position++
 								{
-									position319 := position
+									position394 := position
 									depth++
+								l395:
 									{
-										position322, tokenIndex322, depth322 := position, tokenIndex, depth
-										if buffer[position] != rune(')') {
-											goto l322
-										}
-										position++
-										goto l304
-									l322:
-										position, tokenIndex, depth = position322, tokenIndex322, depth322
-									}
-									if !matchDot() {
-										goto l304
-									}
-								l320:
-									{
-										position321, tokenIndex321, depth321 := position, tokenIndex, depth
+										position396, tokenIndex396, depth396 := position, tokenIndex, depth
 										{
-											position323, tokenIndex323, depth323 := position, tokenIndex, depth
-											if buffer[position] != rune(')') {
-												goto l323
+											position397, tokenIndex397, depth397 := position, tokenIndex, depth
+											if buffer[position] != rune('"') {
+												goto l397
 											}
 											position++
-											goto l321
-										l323:
-											position, tokenIndex, depth = position323, tokenIndex323, depth323
+											goto l396
+										l397:
+											position, tokenIndex, depth = position397, tokenIndex397, depth397
 										}
 										if !matchDot() {
-											goto l321
+											goto l396
 										}
-										goto l320
-									l321:
-										position, tokenIndex, depth = position321, tokenIndex321, depth321
+										goto l395
+									l396:
+										position, tokenIndex, depth = position396, tokenIndex396, depth396
 									}
 									depth--
-									add(rulePegText, position319)
+									add(rulePegText, position394)
 								}
+								if buffer[position] != rune('"') {
+									goto l376
+								}
+								position++
 								if buffer[position] != rune(')') {
-									goto l304
+									goto l376
 								}
 								position++
 								{
-									add(ruleAction47, position)
+									add(ruleAction46, position)
 								}
 								depth--
-								add(ruleNumberLong, position318)
+								add(ruledateString, position389)
 							}
-							break
-						case '/':
+						}
+					l378:
+						depth--
+						add(ruleDate, position377)
+					}
+					goto l353
+				l376:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position400 := position
+						depth++
+						if buffer[position] != rune('B') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l399
+						}
+						position++
+						{
+							position401 := position
+							depth++
+							{
+								position404, tokenIndex404, depth404 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l404
+								}
+								position++
+								goto l399
+							l404:
+								position, tokenIndex, depth = position404, tokenIndex404, depth404
+							}
+							if !matchDot() {
+								goto l399
+							}
+						l402:
+							{
+								position403, tokenIndex403, depth403 := position, tokenIndex, depth
+								{
+									position405, tokenIndex405, depth405 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l405
+									}
+									position++
+									goto l403
+								l405:
+									position, tokenIndex, depth = position405, tokenIndex405, depth405
+								}
+								if !matchDot() {
+									goto l403
+								}
+								goto l402
+							l403:
+								position, tokenIndex, depth = position403, tokenIndex403, depth403
+							}
+							depth--
+							add(rulePegText, position401)
+						}
+						if buffer[position] != rune(')') {
+							goto l399
+						}
+						position++
+						{
+							add(ruleAction48, position)
+						}
+						depth--
+						add(ruleBinData, position400)
+					}
+					goto l353
+				l399:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position408 := position
+						depth++
+						if buffer[position] != rune('U') {
+							goto l407
+						}
+						position++
+						if buffer[position] != rune('U') {
+							goto l407
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l407
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l407
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l407
+						}
+						position++
+						{
+							position409 := position
+							depth++
+							{
+								position412, tokenIndex412, depth412 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l412
+								}
+								position++
+								goto l407
+							l412:
+								position, tokenIndex, depth = position412, tokenIndex412, depth412
+							}
+							if !matchDot() {
+								goto l407
+							}
+						l410:
+							{
+								position411, tokenIndex411, depth411 := position, tokenIndex, depth
+								{
+									position413, tokenIndex413, depth413 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l413
+									}
+									position++
+									goto l411
+								l413:
+									position, tokenIndex, depth = position413, tokenIndex413, depth413
+								}
+								if !matchDot() {
+									goto l411
+								}
+								goto l410
+							l411:
+								position, tokenIndex, depth = position411, tokenIndex411, depth411
+							}
+							depth--
+							add(rulePegText, position409)
+						}
+						if buffer[position] != rune(')') {
+							goto l407
+						}
+						position++
+						{
+							add(ruleAction49, position)
+						}
+						depth--
+						add(ruleUUID, position408)
+					}
+					goto l353
+				l407:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position416 := position
+						depth++
+						if buffer[position] != rune('H') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('x') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l415
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l415
+						}
+						position++
+						{
+							position417 := position
+							depth++
+							{
+								position420, tokenIndex420, depth420 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l420
+								}
+								position++
+								goto l415
+							l420:
+								position, tokenIndex, depth = position420, tokenIndex420, depth420
+							}
+							if !matchDot() {
+								goto l415
+							}
+						l418:
+							{
+								position419, tokenIndex419, depth419 := position, tokenIndex, depth
+								{
+									position421, tokenIndex421, depth421 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l421
+									}
+									position++
+									goto l419
+								l421:
+									position, tokenIndex, depth = position421, tokenIndex421, depth421
+								}
+								if !matchDot() {
+									goto l419
+								}
+								goto l418
+							l419:
+								position, tokenIndex, depth = position419, tokenIndex419, depth419
+							}
+							depth--
+							add(rulePegText, position417)
+						}
+						if buffer[position] != rune(')') {
+							goto l415
+						}
+						position++
+						{
+							add(ruleAction50, position)
+						}
+						depth--
+						add(ruleHexData, position416)
+					}
+					goto l353
+				l415:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position424 := position
+						depth++
+						{
+							position425, tokenIndex425, depth425 := position, tokenIndex, depth
 							{
-								position325 := position
+								position427 := position
 								depth++
-								if buffer[position] != rune('/') {
-									goto l304
+								if buffer[position] != rune('T') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l426
+								}
+								position++
+								if buffer[position] != rune('(') {
+									goto l426
 								}
 								position++
 								{
-									position326 := position
+									position428 := position
 									depth++
 									{
-										position327 := position
-										depth++
-										{
-											position330 := position
-											depth++
-											{
-												position331, tokenIndex331, depth331 := position, tokenIndex, depth
-												if buffer[position] != rune('/') {
-													goto l331
-												}
-												position++
-												goto l304
-											l331:
-												position, tokenIndex, depth = position331, tokenIndex331, depth331
-											}
-											if !matchDot() {
-												goto l304
-											}
-											depth--
-											add(ruleregexChar, position330)
-										}
-									l328:
-										{
-											position329, tokenIndex329, depth329 := position, tokenIndex, depth
-											{
-												position332 := position
-												depth++
-												{
-													position333, tokenIndex333, depth333 := position, tokenIndex, depth
-													if buffer[position] != rune('/') {
-														goto l333
-													}
-													position++
-													goto l329
-												l333:
-													position, tokenIndex, depth = position333, tokenIndex333, depth333
-												}
-												if !matchDot() {
-													goto l329
-												}
-												depth--
-												add(ruleregexChar, position332)
-											}
-											goto l328
-										l329:
-											position, tokenIndex, depth = position329, tokenIndex329, depth329
-										}
-										if buffer[position] != rune('/') {
-											goto l304
+										position431, tokenIndex431, depth431 := position, tokenIndex, depth
+										if buffer[position] != rune(')') {
+											goto l431
 										}
 										position++
-									l334:
+										goto l426
+									l431:
+										position, tokenIndex, depth = position431, tokenIndex431, depth431
+									}
+									if !matchDot() {
+										goto l426
+									}
+								l429:
+									{
+										position430, tokenIndex430, depth430 := position, tokenIndex, depth
 										{
-											position335, tokenIndex335, depth335 := position, tokenIndex, depth
-											{
-												switch buffer[position] {
-												case 's':
-													if buffer[position] != rune('s') {
-														goto l335
-													}
-													position++
-													break
-												case 'm':
-													if buffer[position] != rune('m') {
-														goto l335
-													}
-													position++
-													break
-												case 'i':
-													if buffer[position] != rune('i') {
-														goto l335
-													}
-													position++
-													break
-												default:
-													if buffer[position] != rune('g') {
-														goto l335
-													}
-													position++
-													break
-												}
+											position432, tokenIndex432, depth432 := position, tokenIndex, depth
+											if buffer[position] != rune(')') {
+												goto l432
 											}
-
-											goto l334
-										l335:
-											position, tokenIndex, depth = position335, tokenIndex335, depth335
+											position++
+											goto l430
+										l432:
+											position, tokenIndex, depth = position432, tokenIndex432, depth432
 										}
-										depth--
-										add(ruleregexBody, position327)
+										if !matchDot() {
+											goto l430
+										}
+										goto l429
+									l430:
+										position, tokenIndex, depth = position430, tokenIndex430, depth430
 									}
 									depth--
-									add(rulePegText, position326)
+									add(rulePegText, position428)
+								}
+								if buffer[position] != rune(')') {
+									goto l426
 								}
+								position++
 								{
-									add(ruleAction44, position)
+									add(ruleAction52, position)
 								}
 								depth--
-								add(ruleRegex, position325)
+								add(ruletimestampParen, position427)
 							}
-							break
-						case 'T':
+							goto l425
+						l426:
+							position, tokenIndex, depth = position425, tokenIndex425, depth425
 							{
-								position338 := position
+								position434 := position
 								depth++
-								{
-									position339, tokenIndex339, depth339 := position, tokenIndex, depth
-									{
-										position341 := position
-										depth++
-										if buffer[position] != rune('T') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('i') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('t') {
-											goto l340
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l340
+								if buffer[position] != rune('T') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l423
+								}
+								position++
+								if buffer[position] != rune(' ') {
+									goto l423
+								}
+								position++
+								{
+									position435 := position
+									depth++
+									{
+										position438, tokenIndex438, depth438 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l439
+										}
+										position++
+										goto l438
+									l439:
+										position, tokenIndex, depth = position438, tokenIndex438, depth438
+										if buffer[position] != rune('|') {
+											goto l423
+										}
+										position++
+									}
+								l438:
+								l436:
+									{
+										position437, tokenIndex437, depth437 := position, tokenIndex, depth
+										{
+											position440, tokenIndex440, depth440 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l441
+											}
+											position++
+											goto l440
+										l441:
+											position, tokenIndex, depth = position440, tokenIndex440, depth440
+											if buffer[position] != rune('|') {
+												goto l437
+											}
+											position++
+										}
+									l440:
+										goto l436
+									l437:
+										position, tokenIndex, depth = position437, tokenIndex437, depth437
+									}
+									depth--
+									add(rulePegText, position435)
+								}
+								{
+									add(ruleAction53, position)
+								}
+								depth--
+								add(ruletimestampPipe, position434)
+							}
+						}
+					l425:
+						depth--
+						add(ruleTimestampVal, position424)
+					}
+					goto l353
+				l423:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position444 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('L') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l443
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l443
+						}
+						position++
+						{
+							position445 := position
+							depth++
+							{
+								position448, tokenIndex448, depth448 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l448
+								}
+								position++
+								goto l443
+							l448:
+								position, tokenIndex, depth = position448, tokenIndex448, depth448
+							}
+							if !matchDot() {
+								goto l443
+							}
+						l446:
+							{
+								position447, tokenIndex447, depth447 := position, tokenIndex, depth
+								{
+									position449, tokenIndex449, depth449 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l449
+									}
+									position++
+									goto l447
+								l449:
+									position, tokenIndex, depth = position449, tokenIndex449, depth449
+								}
+								if !matchDot() {
+									goto l447
+								}
+								goto l446
+							l447:
+								position, tokenIndex, depth = position447, tokenIndex447, depth447
+							}
+							depth--
+							add(rulePegText, position445)
+						}
+						if buffer[position] != rune(')') {
+							goto l443
+						}
+						position++
+						{
+							add(ruleAction54, position)
+						}
+						depth--
+						add(ruleNumberLong, position444)
+					}
+					goto l353
+				l443:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position452 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('I') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l451
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l451
+						}
+						position++
+						{
+							position453 := position
+							depth++
+							{
+								position456, tokenIndex456, depth456 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l456
+								}
+								position++
+								goto l451
+							l456:
+								position, tokenIndex, depth = position456, tokenIndex456, depth456
+							}
+							if !matchDot() {
+								goto l451
+							}
+						l454:
+							{
+								position455, tokenIndex455, depth455 := position, tokenIndex, depth
+								{
+									position457, tokenIndex457, depth457 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l457
+									}
+									position++
+									goto l455
+								l457:
+									position, tokenIndex, depth = position457, tokenIndex457, depth457
+								}
+								if !matchDot() {
+									goto l455
+								}
+								goto l454
+							l455:
+								position, tokenIndex, depth = position455, tokenIndex455, depth455
+							}
+							depth--
+							add(rulePegText, position453)
+						}
+						if buffer[position] != rune(')') {
+							goto l451
+						}
+						position++
+						{
+							add(ruleAction55, position)
+						}
+						depth--
+						add(ruleNumberInt, position452)
+					}
+					goto l353
+				l451:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position460 := position
+						depth++
+						if buffer[position] != rune('N') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('D') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l459
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l459
+						}
+						position++
+						{
+							position461 := position
+							depth++
+							{
+								position464, tokenIndex464, depth464 := position, tokenIndex, depth
+								if buffer[position] != rune(')') {
+									goto l464
+								}
+								position++
+								goto l459
+							l464:
+								position, tokenIndex, depth = position464, tokenIndex464, depth464
+							}
+							if !matchDot() {
+								goto l459
+							}
+						l462:
+							{
+								position463, tokenIndex463, depth463 := position, tokenIndex, depth
+								{
+									position465, tokenIndex465, depth465 := position, tokenIndex, depth
+									if buffer[position] != rune(')') {
+										goto l465
+									}
+									position++
+									goto l463
+								l465:
+									position, tokenIndex, depth = position465, tokenIndex465, depth465
+								}
+								if !matchDot() {
+									goto l463
+								}
+								goto l462
+							l463:
+								position, tokenIndex, depth = position463, tokenIndex463, depth463
+							}
+							depth--
+							add(rulePegText, position461)
+						}
+						if buffer[position] != rune(')') {
+							goto l459
+						}
+						position++
+						{
+							add(ruleAction56, position)
+						}
+						depth--
+						add(ruleNumberDecimal, position460)
+					}
+					goto l353
+				l459:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position468 := position
+						depth++
+						if buffer[position] != rune('C') {
+							goto l467
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l467
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l467
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l467
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l467
+						}
+						position++
+						{
+							position469, tokenIndex469, depth469 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l469
+							}
+							goto l470
+						l469:
+							position, tokenIndex, depth = position469, tokenIndex469, depth469
+						}
+					l470:
+						if !_rules[rulecodeArg]() {
+							goto l467
+						}
+						{
+							position471, tokenIndex471, depth471 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l471
+							}
+							goto l472
+						l471:
+							position, tokenIndex, depth = position471, tokenIndex471, depth471
+						}
+					l472:
+						if buffer[position] != rune(')') {
+							goto l467
+						}
+						position++
+						{
+							add(ruleAction58, position)
+						}
+						depth--
+						add(ruleCode, position468)
+					}
+					goto l353
+				l467:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position475 := position
+						depth++
+						if buffer[position] != rune('C') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('W') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('S') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l474
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l474
+						}
+						position++
+						{
+							position476, tokenIndex476, depth476 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l476
+							}
+							goto l477
+						l476:
+							position, tokenIndex, depth = position476, tokenIndex476, depth476
+						}
+					l477:
+						if !_rules[rulecodeArg]() {
+							goto l474
+						}
+						{
+							position478, tokenIndex478, depth478 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l478
+							}
+							goto l479
+						l478:
+							position, tokenIndex, depth = position478, tokenIndex478, depth478
+						}
+					l479:
+						if buffer[position] != rune(',') {
+							goto l474
+						}
+						position++
+						{
+							position480, tokenIndex480, depth480 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l480
+							}
+							goto l481
+						l480:
+							position, tokenIndex, depth = position480, tokenIndex480, depth480
+						}
+					l481:
+						if !_rules[ruleDoc]() {
+							goto l474
+						}
+						{
+							position482, tokenIndex482, depth482 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l482
+							}
+							goto l483
+						l482:
+							position, tokenIndex, depth = position482, tokenIndex482, depth482
+						}
+					l483:
+						if buffer[position] != rune(')') {
+							goto l474
+						}
+						position++
+						{
+							add(ruleAction59, position)
+						}
+						depth--
+						add(ruleCodeWScope, position475)
+					}
+					goto l353
+				l474:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position486 := position
+						depth++
+						if buffer[position] != rune('D') {
+							goto l485
+						}
+						position++
+						if buffer[position] != rune('B') {
+							goto l485
+						}
+						position++
+						if buffer[position] != rune('R') {
+							goto l485
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l485
+						}
+						position++
+						if buffer[position] != rune('f') {
+							goto l485
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l485
+						}
+						position++
+						{
+							position487, tokenIndex487, depth487 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l487
+							}
+							goto l488
+						l487:
+							position, tokenIndex, depth = position487, tokenIndex487, depth487
+						}
+					l488:
+						if !_rules[rulerefName]() {
+							goto l485
+						}
+						{
+							position489, tokenIndex489, depth489 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l489
+							}
+							goto l490
+						l489:
+							position, tokenIndex, depth = position489, tokenIndex489, depth489
+						}
+					l490:
+						if buffer[position] != rune(',') {
+							goto l485
+						}
+						position++
+						{
+							position491, tokenIndex491, depth491 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l491
+							}
+							goto l492
+						l491:
+							position, tokenIndex, depth = position491, tokenIndex491, depth491
+						}
+					l492:
+						if !_rules[ruleValue]() {
+							goto l485
+						}
+						{
+							position493, tokenIndex493, depth493 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l493
+							}
+							goto l494
+						l493:
+							position, tokenIndex, depth = position493, tokenIndex493, depth493
+						}
+					l494:
+						{
+							position495, tokenIndex495, depth495 := position, tokenIndex, depth
+							if buffer[position] != rune(',') {
+								goto l496
+							}
+							position++
+							{
+								position497, tokenIndex497, depth497 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l497
+								}
+								goto l498
+							l497:
+								position, tokenIndex, depth = position497, tokenIndex497, depth497
+							}
+						l498:
+							if !_rules[rulerefName]() {
+								goto l496
+							}
+							{
+								position499, tokenIndex499, depth499 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l499
+								}
+								goto l500
+							l499:
+								position, tokenIndex, depth = position499, tokenIndex499, depth499
+							}
+						l500:
+							if buffer[position] != rune(')') {
+								goto l496
+							}
+							position++
+							{
+								add(ruleAction60, position)
+							}
+							goto l495
+						l496:
+							position, tokenIndex, depth = position495, tokenIndex495, depth495
+							if buffer[position] != rune(')') {
+								goto l485
+							}
+							position++
+							{
+								add(ruleAction61, position)
+							}
+						}
+					l495:
+						depth--
+						add(ruleDBRef, position486)
+					}
+					goto l353
+				l485:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position504 := position
+						depth++
+						{
+							position505, tokenIndex505, depth505 := position, tokenIndex, depth
+							if buffer[position] != rune('D') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('B') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('P') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l506
+							}
+							position++
+							if buffer[position] != rune('(') {
+								goto l506
+							}
+							position++
+							goto l505
+						l506:
+							position, tokenIndex, depth = position505, tokenIndex505, depth505
+							if buffer[position] != rune('D') {
+								goto l503
+							}
+							position++
+							if buffer[position] != rune('B') {
+								goto l503
+							}
+							position++
+							if buffer[position] != rune('R') {
+								goto l503
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l503
+							}
+							position++
+							if buffer[position] != rune('f') {
+								goto l503
+							}
+							position++
+							if buffer[position] != rune('(') {
+								goto l503
+							}
+							position++
+						}
+					l505:
+						{
+							position507, tokenIndex507, depth507 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l507
+							}
+							goto l508
+						l507:
+							position, tokenIndex, depth = position507, tokenIndex507, depth507
+						}
+					l508:
+						if !_rules[rulerefName]() {
+							goto l503
+						}
+						{
+							position509, tokenIndex509, depth509 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l509
+							}
+							goto l510
+						l509:
+							position, tokenIndex, depth = position509, tokenIndex509, depth509
+						}
+					l510:
+						if buffer[position] != rune(',') {
+							goto l503
+						}
+						position++
+						{
+							position511, tokenIndex511, depth511 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l511
+							}
+							goto l512
+						l511:
+							position, tokenIndex, depth = position511, tokenIndex511, depth511
+						}
+					l512:
+						{
+							position513 := position
+							depth++
+							{
+								position514, tokenIndex514, depth514 := position, tokenIndex, depth
+								if buffer[position] != rune('O') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('b') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('j') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('I') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l515
+								}
+								position++
+								if buffer[position] != rune('(') {
+									goto l515
+								}
+								position++
+								{
+									position516, tokenIndex516, depth516 := position, tokenIndex, depth
+									if buffer[position] != rune('\'') {
+										goto l517
+									}
+									position++
+									goto l516
+								l517:
+									position, tokenIndex, depth = position516, tokenIndex516, depth516
+									if buffer[position] != rune('"') {
+										goto l515
+									}
+									position++
+								}
+							l516:
+								{
+									position518 := position
+									depth++
+								l519:
+									{
+										position520, tokenIndex520, depth520 := position, tokenIndex, depth
+										if !_rules[rulehexChar]() {
+											goto l520
+										}
+										goto l519
+									l520:
+										position, tokenIndex, depth = position520, tokenIndex520, depth520
+									}
+									depth--
+									add(rulePegText, position518)
+								}
+								{
+									position521, tokenIndex521, depth521 := position, tokenIndex, depth
+									if buffer[position] != rune('\'') {
+										goto l522
+									}
+									position++
+									goto l521
+								l522:
+									position, tokenIndex, depth = position521, tokenIndex521, depth521
+									if buffer[position] != rune('"') {
+										goto l515
+									}
+									position++
+								}
+							l521:
+								if buffer[position] != rune(')') {
+									goto l515
+								}
+								position++
+								goto l514
+							l515:
+								position, tokenIndex, depth = position514, tokenIndex514, depth514
+								{
+									position523 := position
+									depth++
+									if !_rules[rulehexChar]() {
+										goto l503
+									}
+								l524:
+									{
+										position525, tokenIndex525, depth525 := position, tokenIndex, depth
+										if !_rules[rulehexChar]() {
+											goto l525
+										}
+										goto l524
+									l525:
+										position, tokenIndex, depth = position525, tokenIndex525, depth525
+									}
+									depth--
+									add(rulePegText, position523)
+								}
+							}
+						l514:
+							{
+								add(ruleAction73, position)
+							}
+							depth--
+							add(rulerefId, position513)
+						}
+						{
+							position527, tokenIndex527, depth527 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l527
+							}
+							goto l528
+						l527:
+							position, tokenIndex, depth = position527, tokenIndex527, depth527
+						}
+					l528:
+						if buffer[position] != rune(')') {
+							goto l503
+						}
+						position++
+						{
+							add(ruleAction62, position)
+						}
+						depth--
+						add(ruleDBPointer, position504)
+					}
+					goto l353
+				l503:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position531 := position
+						depth++
+						if buffer[position] != rune('S') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('b') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l530
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l530
+						}
+						position++
+						{
+							position532, tokenIndex532, depth532 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l532
+							}
+							goto l533
+						l532:
+							position, tokenIndex, depth = position532, tokenIndex532, depth532
+						}
+					l533:
+						if !_rules[ruleString]() {
+							goto l530
+						}
+						{
+							position534, tokenIndex534, depth534 := position, tokenIndex, depth
+							if !_rules[ruleS]() {
+								goto l534
+							}
+							goto l535
+						l534:
+							position, tokenIndex, depth = position534, tokenIndex534, depth534
+						}
+					l535:
+						if buffer[position] != rune(')') {
+							goto l530
+						}
+						position++
+						{
+							add(ruleAction63, position)
+						}
+						depth--
+						add(ruleSymbol, position531)
+					}
+					goto l353
+				l530:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position538 := position
+						depth++
+						if !(p.Constructors) {
+							goto l537
+						}
+						{
+							position539, tokenIndex539, depth539 := position, tokenIndex, depth
+							{
+								position540 := position
+								depth++
+								{
+									position541, tokenIndex541, depth541 := position, tokenIndex, depth
+									if buffer[position] != rune('C') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('W') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('S') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('c') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('p') {
+										goto l542
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l542
+									}
+									position++
+									goto l541
+								l542:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('D') {
+										goto l543
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l543
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l543
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l543
+									}
+									position++
+									goto l541
+								l543:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('U') {
+										goto l544
+									}
+									position++
+									if buffer[position] != rune('U') {
+										goto l544
+									}
+									position++
+									if buffer[position] != rune('I') {
+										goto l544
+									}
+									position++
+									if buffer[position] != rune('D') {
+										goto l544
+									}
+									position++
+									goto l541
+								l544:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('N') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('m') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('b') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('L') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l545
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l545
+									}
+									position++
+									goto l541
+								l545:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('N') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('m') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('b') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('I') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l546
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l546
+									}
+									position++
+									goto l541
+								l546:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('R') {
+										goto l547
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l547
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l547
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l547
+									}
+									position++
+									if buffer[position] != rune('x') {
+										goto l547
+									}
+									position++
+									goto l541
+								l547:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('M') {
+										goto l548
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l548
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l548
+									}
+									position++
+									if buffer[position] != rune('K') {
+										goto l548
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l548
+									}
+									position++
+									if buffer[position] != rune('y') {
+										goto l548
+									}
+									position++
+									goto l541
+								l548:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									if buffer[position] != rune('D') {
+										goto l549
+									}
+									position++
+									if buffer[position] != rune('B') {
+										goto l549
+									}
+									position++
+									if buffer[position] != rune('R') {
+										goto l549
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l549
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l549
+									}
+									position++
+									goto l541
+								l549:
+									position, tokenIndex, depth = position541, tokenIndex541, depth541
+									{
+										switch buffer[position] {
+										case 'S':
+											if buffer[position] != rune('S') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l539
+											}
+											position++
+											break
+										case 'D':
+											if buffer[position] != rune('D') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('B') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('P') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l539
+											}
+											position++
+											break
+										case 'U':
+											if buffer[position] != rune('U') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l539
+											}
+											position++
+											break
+										case 'M':
+											if buffer[position] != rune('M') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('K') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l539
+											}
+											position++
+											break
+										case 'R':
+											if buffer[position] != rune('R') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('E') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l539
+											}
+											position++
+											break
+										case 'N':
+											if buffer[position] != rune('N') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l539
+											}
+											position++
+											break
+										case 'T':
+											if buffer[position] != rune('T') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l539
+											}
+											position++
+											break
+										case 'H':
+											if buffer[position] != rune('H') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('x') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											break
+										case 'B':
+											if buffer[position] != rune('B') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											break
+										case 'O':
+											if buffer[position] != rune('O') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('j') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('I') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l539
+											}
+											position++
+											break
+										case 'I':
+											if buffer[position] != rune('I') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('S') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('O') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('D') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											break
+										default:
+											if buffer[position] != rune('C') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l539
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l539
+											}
+											position++
+											break
+										}
+									}
+
+								}
+							l541:
+								depth--
+								add(rulebuiltinName, position540)
+							}
+							if buffer[position] != rune('(') {
+								goto l539
+							}
+							position++
+							goto l537
+						l539:
+							position, tokenIndex, depth = position539, tokenIndex539, depth539
+						}
+						{
+							position551 := position
+							depth++
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l537
+							}
+							position++
+						l552:
+							{
+								position553, tokenIndex553, depth553 := position, tokenIndex, depth
+								{
+									switch buffer[position] {
+									case '_':
+										if buffer[position] != rune('_') {
+											goto l553
 										}
 										position++
-										if buffer[position] != rune('m') {
-											goto l340
+										break
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l553
 										}
 										position++
-										if buffer[position] != rune('p') {
-											goto l340
+										break
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										if c := buffer[position]; c < rune('A') || c > rune('Z') {
+											goto l553
 										}
 										position++
-										if buffer[position] != rune('(') {
-											goto l340
+										break
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l553
 										}
 										position++
+										break
+									}
+								}
+
+								goto l552
+							l553:
+								position, tokenIndex, depth = position553, tokenIndex553, depth553
+							}
+							depth--
+							add(rulePegText, position551)
+						}
+						if buffer[position] != rune('(') {
+							goto l537
+						}
+						position++
+						{
+							add(ruleAction64, position)
+						}
+						{
+							position556, tokenIndex556, depth556 := position, tokenIndex, depth
+							{
+								position558, tokenIndex558, depth558 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l558
+								}
+								goto l559
+							l558:
+								position, tokenIndex, depth = position558, tokenIndex558, depth558
+							}
+						l559:
+							if !_rules[ruleValue]() {
+								goto l556
+							}
+							{
+								position560, tokenIndex560, depth560 := position, tokenIndex, depth
+								if !_rules[ruleS]() {
+									goto l560
+								}
+								goto l561
+							l560:
+								position, tokenIndex, depth = position560, tokenIndex560, depth560
+							}
+						l561:
+							{
+								add(ruleAction65, position)
+							}
+						l563:
+							{
+								position564, tokenIndex564, depth564 := position, tokenIndex, depth
+								if buffer[position] != rune(',') {
+									goto l564
+								}
+								position++
+								{
+									position565, tokenIndex565, depth565 := position, tokenIndex, depth
+									if !_rules[ruleS]() {
+										goto l565
+									}
+									goto l566
+								l565:
+									position, tokenIndex, depth = position565, tokenIndex565, depth565
+								}
+							l566:
+								if !_rules[ruleValue]() {
+									goto l564
+								}
+								{
+									position567, tokenIndex567, depth567 := position, tokenIndex, depth
+									if !_rules[ruleS]() {
+										goto l567
+									}
+									goto l568
+								l567:
+									position, tokenIndex, depth = position567, tokenIndex567, depth567
+								}
+							l568:
+								{
+									add(ruleAction66, position)
+								}
+								goto l563
+							l564:
+								position, tokenIndex, depth = position564, tokenIndex564, depth564
+							}
+							goto l557
+						l556:
+							position, tokenIndex, depth = position556, tokenIndex556, depth556
+						}
+					l557:
+						if buffer[position] != rune(')') {
+							goto l537
+						}
+						position++
+						{
+							add(ruleAction67, position)
+						}
+						depth--
+						add(ruleConstructor, position538)
+					}
+					goto l353
+				l537:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position572 := position
+						depth++
+						if buffer[position] != rune('M') {
+							goto l571
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l571
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l571
+						}
+						position++
+						if buffer[position] != rune('K') {
+							goto l571
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l571
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l571
+						}
+						position++
+						{
+							add(ruleAction68, position)
+						}
+						depth--
+						add(ruleMinKey, position572)
+					}
+					goto l353
+				l571:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						switch buffer[position] {
+						case 'M':
+							{
+								position575 := position
+								depth++
+								if buffer[position] != rune('M') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('x') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('K') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l351
+								}
+								position++
+								{
+									add(ruleAction69, position)
+								}
+								depth--
+								add(ruleMaxKey, position575)
+							}
+							break
+						case 'u':
+							{
+								position577 := position
+								depth++
+								if buffer[position] != rune('u') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l351
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l351
+								}
+								position++
+								{
+									add(ruleAction70, position)
+								}
+								depth--
+								add(ruleUndefined, position577)
+							}
+							break
+						case 'f':
+							{
+								position579 := position
+								depth++
+								{
+									position580 := position
+									depth++
+									if !_rules[rulejsFunction]() {
+										goto l351
+									}
+									depth--
+									add(rulePegText, position580)
+								}
+								{
+									add(ruleAction57, position)
+								}
+								depth--
+								add(ruleJavaScript, position579)
+							}
+							break
+						case '/':
+							{
+								position582 := position
+								depth++
+								if buffer[position] != rune('/') {
+									goto l351
+								}
+								position++
+								{
+									position583 := position
+									depth++
+									{
+										position584 := position
+										depth++
 										{
-											position342 := position
+											position587 := position
 											depth++
 											{
-												position345, tokenIndex345, depth345 := position, tokenIndex, depth
-												if buffer[position] != rune(')') {
-													goto l345
+												position588, tokenIndex588, depth588 := position, tokenIndex, depth
+												if buffer[position] != rune('/') {
+													goto l588
 												}
 												position++
-												goto l340
-											l345:
-												position, tokenIndex, depth = position345, tokenIndex345, depth345
+												goto l351
+											l588:
+												position, tokenIndex, depth = position588, tokenIndex588, depth588
 											}
 											if !matchDot() {
-												goto l340
+												goto l351
 											}
-										l343:
+											depth--
+											add(ruleregexChar, position587)
+										}
+									l585:
+										{
+											position586, tokenIndex586, depth586 := position, tokenIndex, depth
 											{
-												position344, tokenIndex344, depth344 := position, tokenIndex, depth
+												position589 := position
+												depth++
 												{
-													position346, tokenIndex346, depth346 := position, tokenIndex, depth
-													if buffer[position] != rune(')') {
-														goto l346
+													position590, tokenIndex590, depth590 := position, tokenIndex, depth
+													if buffer[position] != rune('/') {
+														goto l590
 													}
 													position++
-													goto l344
-												l346:
-													position, tokenIndex, depth = position346, tokenIndex346, depth346
+													goto l586
+												l590:
+													position, tokenIndex, depth = position590, tokenIndex590, depth590
 												}
 												if !matchDot() {
-													goto l344
+													goto l586
 												}
-												goto l343
-											l344:
-												position, tokenIndex, depth = position344, tokenIndex344, depth344
+												depth--
+												add(ruleregexChar, position589)
 											}
-											depth--
-											add(rulePegText, position342)
-										}
-										if buffer[position] != rune(')') {
-											goto l340
-										}
-										position++
-										{
-											add(ruleAction45, position)
-										}
-										depth--
-										add(ruletimestampParen, position341)
-									}
-									goto l339
-								l340:
-									position, tokenIndex, depth = position339, tokenIndex339, depth339
-									{
-										position348 := position
-										depth++
-										if buffer[position] != rune('T') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('i') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('t') {
-											goto l304
+											goto l585
+										l586:
+											position, tokenIndex, depth = position586, tokenIndex586, depth586
 										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('m') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('p') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune(' ') {
-											goto l304
+										if buffer[position] != rune('/') {
+											goto l351
 										}
 										position++
+									l591:
 										{
-											position349 := position
-											depth++
-											{
-												position352, tokenIndex352, depth352 := position, tokenIndex, depth
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l353
-												}
-												position++
-												goto l352
-											l353:
-												position, tokenIndex, depth = position352, tokenIndex352, depth352
-												if buffer[position] != rune('|') {
-													goto l304
-												}
-												position++
-											}
-										l352:
-										l350:
+											position592, tokenIndex592, depth592 := position, tokenIndex, depth
 											{
-												position351, tokenIndex351, depth351 := position, tokenIndex, depth
-												{
-													position354, tokenIndex354, depth354 := position, tokenIndex, depth
-													if c := buffer[position]; c < rune('0') || c > rune('9') {
-														goto l355
+												switch buffer[position] {
+												case 's':
+													if buffer[position] != rune('s') {
+														goto l592
+													}
+													position++
+													break
+												case 'm':
+													if buffer[position] != rune('m') {
+														goto l592
+													}
+													position++
+													break
+												case 'i':
+													if buffer[position] != rune('i') {
+														goto l592
 													}
 													position++
-													goto l354
-												l355:
-													position, tokenIndex, depth = position354, tokenIndex354, depth354
-													if buffer[position] != rune('|') {
-														goto l351
+													break
+												default:
+													if buffer[position] != rune('g') {
+														goto l592
 													}
 													position++
+													break
 												}
-											l354:
-												goto l350
-											l351:
-												position, tokenIndex, depth = position351, tokenIndex351, depth351
 											}
-											depth--
-											add(rulePegText, position349)
-										}
-										{
-											add(ruleAction46, position)
+
+											goto l591
+										l592:
+											position, tokenIndex, depth = position592, tokenIndex592, depth592
 										}
 										depth--
-										add(ruletimestampPipe, position348)
+										add(ruleregexBody, position584)
 									}
+									depth--
+									add(rulePegText, position583)
+								}
+								{
+									add(ruleAction51, position)
 								}
-							l339:
 								depth--
-								add(ruleTimestampVal, position338)
+								add(ruleRegex, position582)
+							}
+							break
+						case '"':
+							if !_rules[ruleString]() {
+								goto l351
 							}
 							break
-						case 'B':
+						case '[':
 							{
-								position357 := position
+								position595 := position
 								depth++
-								if buffer[position] != rune('B') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('i') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('n') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('D') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('a') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('t') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('a') {
-									goto l304
+								if buffer[position] != rune('[') {
+									goto l351
 								}
 								position++
-								if buffer[position] != rune('(') {
-									goto l304
+								{
+									add(ruleAction35, position)
 								}
-								position++
 								{
-									position358 := position
-									depth++
+									position597, tokenIndex597, depth597 := position, tokenIndex, depth
 									{
-										position361, tokenIndex361, depth361 := position, tokenIndex, depth
-										if buffer[position] != rune(')') {
-											goto l361
+										position599 := position
+										depth++
+										if !_rules[ruleListElem]() {
+											goto l597
 										}
-										position++
-										goto l304
-									l361:
-										position, tokenIndex, depth = position361, tokenIndex361, depth361
-									}
-									if !matchDot() {
-										goto l304
-									}
-								l359:
-									{
-										position360, tokenIndex360, depth360 := position, tokenIndex, depth
+									l600:
 										{
-											position362, tokenIndex362, depth362 := position, tokenIndex, depth
-											if buffer[position] != rune(')') {
-												goto l362
+											position601, tokenIndex601, depth601 := position, tokenIndex, depth
+											if buffer[position] != rune(',') {
+												goto l601
 											}
 											position++
-											goto l360
-										l362:
-											position, tokenIndex, depth = position362, tokenIndex362, depth362
-										}
-										if !matchDot() {
-											goto l360
+											if !_rules[ruleListElem]() {
+												goto l601
+											}
+											goto l600
+										l601:
+											position, tokenIndex, depth = position601, tokenIndex601, depth601
 										}
-										goto l359
-									l360:
-										position, tokenIndex, depth = position360, tokenIndex360, depth360
+										depth--
+										add(ruleListElements, position599)
 									}
-									depth--
-									add(rulePegText, position358)
+									goto l598
+								l597:
+									position, tokenIndex, depth = position597, tokenIndex597, depth597
 								}
-								if buffer[position] != rune(')') {
-									goto l304
+							l598:
+								if buffer[position] != rune(']') {
+									goto l351
 								}
 								position++
 								{
-									add(ruleAction43, position)
+									add(ruleAction36, position)
 								}
 								depth--
-								add(ruleBinData, position357)
+								add(ruleList, position595)
 							}
 							break
-						case 'D', 'n':
+						default:
+							if !_rules[ruleDoc]() {
+								goto l351
+							}
+							break
+						}
+					}
+
+				}
+			l353:
+				depth--
+				add(ruleValue, position352)
+			}
+			return true
+		l351:
+			position, tokenIndex, depth = position351, tokenIndex351, depth351
+			return false
+		},
+		/* 59 Numeric <- <(<('-'? (numberSpecial / numberHex / numberDecimal))> Action40)> */
+		func() bool {
+			position603, tokenIndex603, depth603 := position, tokenIndex, depth
+			{
+				position604 := position
+				depth++
+				{
+					position605 := position
+					depth++
+					{
+						position606, tokenIndex606, depth606 := position, tokenIndex, depth
+						if buffer[position] != rune('-') {
+							goto l606
+						}
+						position++
+						goto l607
+					l606:
+						position, tokenIndex, depth = position606, tokenIndex606, depth606
+					}
+				l607:
+					{
+						position608, tokenIndex608, depth608 := position, tokenIndex, depth
+						{
+							position610 := position
+							depth++
 							{
-								position364 := position
-								depth++
-								{
-									position365, tokenIndex365, depth365 := position, tokenIndex, depth
+								switch buffer[position] {
+								case 'n':
 									if buffer[position] != rune('n') {
-										goto l365
+										goto l609
 									}
 									position++
-									if buffer[position] != rune('e') {
-										goto l365
+									if buffer[position] != rune('a') {
+										goto l609
 									}
 									position++
-									if buffer[position] != rune('w') {
-										goto l365
+									if buffer[position] != rune('n') {
+										goto l609
 									}
 									position++
-									if buffer[position] != rune(' ') {
-										goto l365
+									break
+								case 'N':
+									if buffer[position] != rune('N') {
+										goto l609
 									}
 									position++
-									goto l366
-								l365:
-									position, tokenIndex, depth = position365, tokenIndex365, depth365
-								}
-							l366:
-								if buffer[position] != rune('D') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('a') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('t') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('(') {
-									goto l304
-								}
-								position++
-								{
-									position367, tokenIndex367, depth367 := position, tokenIndex, depth
-									if buffer[position] != rune('-') {
-										goto l367
+									if buffer[position] != rune('a') {
+										goto l609
 									}
 									position++
-									goto l368
-								l367:
-									position, tokenIndex, depth = position367, tokenIndex367, depth367
-								}
-							l368:
-								{
-									position369 := position
-									depth++
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l304
+									if buffer[position] != rune('N') {
+										goto l609
 									}
 									position++
-								l370:
-									{
-										position371, tokenIndex371, depth371 := position, tokenIndex, depth
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l371
-										}
-										position++
-										goto l370
-									l371:
-										position, tokenIndex, depth = position371, tokenIndex371, depth371
+									break
+								case 'i':
+									if buffer[position] != rune('i') {
+										goto l609
 									}
-									depth--
-									add(rulePegText, position369)
-								}
-								if buffer[position] != rune(')') {
-									goto l304
-								}
-								position++
-								{
-									add(ruleAction41, position)
+									position++
+									if buffer[position] != rune('n') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l609
+									}
+									position++
+									break
+								default:
+									if buffer[position] != rune('I') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l609
+									}
+									position++
+									if buffer[position] != rune('y') {
+										goto l609
+									}
+									position++
+									break
 								}
-								depth--
-								add(ruleDate, position364)
 							}
-							break
-						case 'O':
+
+							depth--
+							add(rulenumberSpecial, position610)
+						}
+						goto l608
+					l609:
+						position, tokenIndex, depth = position608, tokenIndex608, depth608
+						{
+							position613 := position
+							depth++
+							if buffer[position] != rune('0') {
+								goto l612
+							}
+							position++
 							{
-								position373 := position
-								depth++
-								if buffer[position] != rune('O') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('b') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('j') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('e') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('c') {
-									goto l304
-								}
-								position++
-								if buffer[position] != rune('t') {
-									goto l304
+								position614, tokenIndex614, depth614 := position, tokenIndex, depth
+								if buffer[position] != rune('x') {
+									goto l615
 								}
 								position++
-								if buffer[position] != rune('I') {
-									goto l304
+								goto l614
+							l615:
+								position, tokenIndex, depth = position614, tokenIndex614, depth614
+								if buffer[position] != rune('X') {
+									goto l612
 								}
 								position++
-								if buffer[position] != rune('d') {
-									goto l304
+							}
+						l614:
+							if !_rules[rulehexChar]() {
+								goto l612
+							}
+						l616:
+							{
+								position617, tokenIndex617, depth617 := position, tokenIndex, depth
+								if !_rules[rulehexChar]() {
+									goto l617
 								}
-								position++
-								if buffer[position] != rune('(') {
-									goto l304
+								goto l616
+							l617:
+								position, tokenIndex, depth = position617, tokenIndex617, depth617
+							}
+							depth--
+							add(rulenumberHex, position613)
+						}
+						goto l608
+					l612:
+						position, tokenIndex, depth = position608, tokenIndex608, depth608
+						{
+							position618 := position
+							depth++
+							{
+								position619, tokenIndex619, depth619 := position, tokenIndex, depth
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l620
 								}
 								position++
+							l621:
 								{
-									position374, tokenIndex374, depth374 := position, tokenIndex, depth
-									if buffer[position] != rune('\'') {
-										goto l375
-									}
-									position++
-									goto l374
-								l375:
-									position, tokenIndex, depth = position374, tokenIndex374, depth374
-									if buffer[position] != rune('"') {
-										goto l304
+									position622, tokenIndex622, depth622 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l622
 									}
 									position++
+									goto l621
+								l622:
+									position, tokenIndex, depth = position622, tokenIndex622, depth622
 								}
-							l374:
 								{
-									position376 := position
-									depth++
-								l377:
+									position623, tokenIndex623, depth623 := position, tokenIndex, depth
+									if buffer[position] != rune('.') {
+										goto l623
+									}
+									position++
+								l625:
 									{
-										position378, tokenIndex378, depth378 := position, tokenIndex, depth
-										{
-											position379 := position
-											depth++
-											{
-												position380, tokenIndex380, depth380 := position, tokenIndex, depth
-												if c := buffer[position]; c < rune('0') || c > rune('9') {
-													goto l381
-												}
-												position++
-												goto l380
-											l381:
-												position, tokenIndex, depth = position380, tokenIndex380, depth380
-												{
-													position382, tokenIndex382, depth382 := position, tokenIndex, depth
-													if c := buffer[position]; c < rune('a') || c > rune('f') {
-														goto l383
-													}
-													position++
-													goto l382
-												l383:
-													position, tokenIndex, depth = position382, tokenIndex382, depth382
-													if c := buffer[position]; c < rune('A') || c > rune('F') {
-														goto l378
-													}
-													position++
-												}
-											l382:
-											}
-										l380:
-											depth--
-											add(rulehexChar, position379)
+										position626, tokenIndex626, depth626 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l626
 										}
-										goto l377
-									l378:
-										position, tokenIndex, depth = position378, tokenIndex378, depth378
+										position++
+										goto l625
+									l626:
+										position, tokenIndex, depth = position626, tokenIndex626, depth626
 									}
-									depth--
-									add(rulePegText, position376)
+									goto l624
+								l623:
+									position, tokenIndex, depth = position623, tokenIndex623, depth623
+								}
+							l624:
+								goto l619
+							l620:
+								position, tokenIndex, depth = position619, tokenIndex619, depth619
+								if buffer[position] != rune('.') {
+									goto l603
+								}
+								position++
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l603
 								}
+								position++
+							l627:
 								{
-									position384, tokenIndex384, depth384 := position, tokenIndex, depth
-									if buffer[position] != rune('\'') {
-										goto l385
+									position628, tokenIndex628, depth628 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l628
 									}
 									position++
-									goto l384
-								l385:
-									position, tokenIndex, depth = position384, tokenIndex384, depth384
-									if buffer[position] != rune('"') {
-										goto l304
+									goto l627
+								l628:
+									position, tokenIndex, depth = position628, tokenIndex628, depth628
+								}
+							}
+						l619:
+							{
+								position629, tokenIndex629, depth629 := position, tokenIndex, depth
+								{
+									position631, tokenIndex631, depth631 := position, tokenIndex, depth
+									if buffer[position] != rune('e') {
+										goto l632
+									}
+									position++
+									goto l631
+								l632:
+									position, tokenIndex, depth = position631, tokenIndex631, depth631
+									if buffer[position] != rune('E') {
+										goto l629
 									}
 									position++
 								}
-							l384:
-								if buffer[position] != rune(')') {
-									goto l304
+							l631:
+								{
+									position633, tokenIndex633, depth633 := position, tokenIndex, depth
+									{
+										position635, tokenIndex635, depth635 := position, tokenIndex, depth
+										if buffer[position] != rune('-') {
+											goto l636
+										}
+										position++
+										goto l635
+									l636:
+										position, tokenIndex, depth = position635, tokenIndex635, depth635
+										if buffer[position] != rune('+') {
+											goto l633
+										}
+										position++
+									}
+								l635:
+									goto l634
+								l633:
+									position, tokenIndex, depth = position633, tokenIndex633, depth633
+								}
+							l634:
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l629
 								}
 								position++
+							l637:
 								{
-									add(ruleAction42, position)
+									position638, tokenIndex638, depth638 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l638
+									}
+									position++
+									goto l637
+								l638:
+									position, tokenIndex, depth = position638, tokenIndex638, depth638
 								}
-								depth--
-								add(ruleObjectID, position373)
+								goto l630
+							l629:
+								position, tokenIndex, depth = position629, tokenIndex629, depth629
 							}
-							break
-						case '"':
+						l630:
+							depth--
+							add(rulenumberDecimal, position618)
+						}
+					}
+				l608:
+					depth--
+					add(rulePegText, position605)
+				}
+				{
+					add(ruleAction40, position)
+				}
+				depth--
+				add(ruleNumeric, position604)
+			}
+			return true
+		l603:
+			position, tokenIndex, depth = position603, tokenIndex603, depth603
+			return false
+		},
+		/* 60 Boolean <- <(True / False)> */
+		nil,
+		/* 61 String <- <('"' <stringChar*> '"' Action41)> */
+		func() bool {
+			position641, tokenIndex641, depth641 := position, tokenIndex, depth
+			{
+				position642 := position
+				depth++
+				if buffer[position] != rune('"') {
+					goto l641
+				}
+				position++
+				{
+					position643 := position
+					depth++
+				l644:
+					{
+						position645, tokenIndex645, depth645 := position, tokenIndex, depth
+						if !_rules[rulestringChar]() {
+							goto l645
+						}
+						goto l644
+					l645:
+						position, tokenIndex, depth = position645, tokenIndex645, depth645
+					}
+					depth--
+					add(rulePegText, position643)
+				}
+				if buffer[position] != rune('"') {
+					goto l641
+				}
+				position++
+				{
+					add(ruleAction41, position)
+				}
+				depth--
+				add(ruleString, position642)
+			}
+			return true
+		l641:
+			position, tokenIndex, depth = position641, tokenIndex641, depth641
+			return false
+		},
+		/* 62 Null <- <('n' 'u' 'l' 'l' Action42)> */
+		nil,
+		/* 63 True <- <('t' 'r' 'u' 'e' Action43)> */
+		nil,
+		/* 64 False <- <('f' 'a' 'l' 's' 'e' Action44)> */
+		nil,
+		/* 65 Date <- <(dateMillis / dateString)> */
+		nil,
+		/* 66 dateMillis <- <(('n' 'e' 'w' ' ')? ('D' 'a' 't' 'e' '(') <('-'? [0-9]+)> ')' Action45)> */
+		nil,
+		/* 67 dateString <- <(('n' 'e' 'w' ' ')? (('I' 'S' 'O' 'D' 'a' 't' 'e') / ('D' 'a' 't' 'e')) '(' '"' <(!'"' .)*> '"' ')' Action46)> */
+		nil,
+		/* 68 ObjectID <- <('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')' Action47)> */
+		nil,
+		/* 69 BinData <- <('B' 'i' 'n' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action48)> */
+		nil,
+		/* 70 UUID <- <('U' 'U' 'I' 'D' '(' <(!')' .)+> ')' Action49)> */
+		nil,
+		/* 71 HexData <- <('H' 'e' 'x' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action50)> */
+		nil,
+		/* 72 Regex <- <('/' <regexBody> Action51)> */
+		nil,
+		/* 73 TimestampVal <- <(timestampParen / timestampPipe)> */
+		nil,
+		/* 74 timestampParen <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' '(' <(!')' .)+> ')' Action52)> */
+		nil,
+		/* 75 timestampPipe <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' ' ' <([0-9] / '|')+> Action53)> */
+		nil,
+		/* 76 NumberLong <- <('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g' '(' <(!')' .)+> ')' Action54)> */
+		nil,
+		/* 77 NumberInt <- <('N' 'u' 'm' 'b' 'e' 'r' 'I' 'n' 't' '(' <(!')' .)+> ')' Action55)> */
+		nil,
+		/* 78 NumberDecimal <- <('N' 'u' 'm' 'b' 'e' 'r' 'D' 'e' 'c' 'i' 'm' 'a' 'l' '(' <(!')' .)+> ')' Action56)> */
+		nil,
+		/* 79 JavaScript <- <(<jsFunction> Action57)> */
+		nil,
+		/* 80 Code <- <('C' 'o' 'd' 'e' '(' S? codeArg S? ')' Action58)> */
+		nil,
+		/* 81 CodeWScope <- <('C' 'o' 'd' 'e' 'W' 'S' 'c' 'o' 'p' 'e' '(' S? codeArg S? ',' S? Doc S? ')' Action59)> */
+		nil,
+		/* 82 DBRef <- <('D' 'B' 'R' 'e' 'f' '(' S? refName S? ',' S? Value S? ((',' S? refName S? ')' Action60) / (')' Action61)))> */
+		nil,
+		/* 83 DBPointer <- <((('D' 'B' 'P' 'o' 'i' 'n' 't' 'e' 'r' '(') / ('D' 'B' 'R' 'e' 'f' '(')) S? refName S? ',' S? refId S? ')' Action62)> */
+		nil,
+		/* 84 Symbol <- <('S' 'y' 'm' 'b' 'o' 'l' '(' S? String S? ')' Action63)> */
+		nil,
+		/* 85 Constructor <- <(&{ p.Constructors } !(builtinName '(') <([A-Z] ((&('_') '_') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> '(' Action64 (S? Value S? Action65 (',' S? Value S? Action66)*)? ')' Action67)> */
+		nil,
+		/* 86 builtinName <- <(('C' 'o' 'd' 'e' 'W' 'S' 'c' 'o' 'p' 'e') / ('D' 'a' 't' 'e') / ('U' 'U' 'I' 'D') / ('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g') / ('N' 'u' 'm' 'b' 'e' 'r' 'I' 'n' 't') / ('R' 'e' 'g' 'e' 'x') / ('M' 'i' 'n' 'K' 'e' 'y') / ('D' 'B' 'R' 'e' 'f') / ((&('S') ('S' 'y' 'm' 'b' 'o' 'l')) | (&('D') ('D' 'B' 'P' 'o' 'i' 'n' 't' 'e' 'r')) | (&('U') ('U' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd')) | (&('M') ('M' 'a' 'x' 'K' 'e' 'y')) | (&('R') ('R' 'e' 'g' 'E' 'x' 'p')) | (&('N') ('N' 'u' 'm' 'b' 'e' 'r' 'D' 'e' 'c' 'i' 'm' 'a' 'l')) | (&('T') ('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p')) | (&('H') ('H' 'e' 'x' 'D' 'a' 't' 'a')) | (&('B') ('B' 'i' 'n' 'D' 'a' 't' 'a')) | (&('O') ('O' 'b' 'j' 'e' 'c' 't' 'I' 'd')) | (&('I') ('I' 'S' 'O' 'D' 'a' 't' 'e')) | (&('C') ('C' 'o' 'd' 'e'))))> */
+		nil,
+		/* 87 MinKey <- <('M' 'i' 'n' 'K' 'e' 'y' Action68)> */
+		nil,
+		/* 88 MaxKey <- <('M' 'a' 'x' 'K' 'e' 'y' Action69)> */
+		nil,
+		/* 89 Undefined <- <('u' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd' Action70)> */
+		nil,
+		/* 90 hexChar <- <([0-9] / ([a-f] / [A-F]))> */
+		func() bool {
+			position675, tokenIndex675, depth675 := position, tokenIndex, depth
+			{
+				position676 := position
+				depth++
+				{
+					position677, tokenIndex677, depth677 := position, tokenIndex, depth
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l678
+					}
+					position++
+					goto l677
+				l678:
+					position, tokenIndex, depth = position677, tokenIndex677, depth677
+					{
+						position679, tokenIndex679, depth679 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('a') || c > rune('f') {
+							goto l680
+						}
+						position++
+						goto l679
+					l680:
+						position, tokenIndex, depth = position679, tokenIndex679, depth679
+						if c := buffer[position]; c < rune('A') || c > rune('F') {
+							goto l675
+						}
+						position++
+					}
+				l679:
+				}
+			l677:
+				depth--
+				add(rulehexChar, position676)
+			}
+			return true
+		l675:
+			position, tokenIndex, depth = position675, tokenIndex675, depth675
+			return false
+		},
+		/* 91 numberSpecial <- <((&('n') ('n' 'a' 'n')) | (&('N') ('N' 'a' 'N')) | (&('i') ('i' 'n' 'f')) | (&('I') ('I' 'n' 'f' 'i' 'n' 'i' 't' 'y')))> */
+		nil,
+		/* 92 numberHex <- <('0' ('x' / 'X') hexChar+)> */
+		nil,
+		/* 93 numberDecimal <- <((([0-9]+ ('.' [0-9]*)?) / ('.' [0-9]+)) (('e' / 'E') ('-' / '+')? [0-9]+)?)> */
+		nil,
+		/* 94 codeArg <- <(String / (<jsFunction> Action71))> */
+		func() bool {
+			position684, tokenIndex684, depth684 := position, tokenIndex, depth
+			{
+				position685 := position
+				depth++
+				{
+					position686, tokenIndex686, depth686 := position, tokenIndex, depth
+					if !_rules[ruleString]() {
+						goto l687
+					}
+					goto l686
+				l687:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
+					{
+						position688 := position
+						depth++
+						if !_rules[rulejsFunction]() {
+							goto l684
+						}
+						depth--
+						add(rulePegText, position688)
+					}
+					{
+						add(ruleAction71, position)
+					}
+				}
+			l686:
+				depth--
+				add(rulecodeArg, position685)
+			}
+			return true
+		l684:
+			position, tokenIndex, depth = position684, tokenIndex684, depth684
+			return false
+		},
+		/* 95 jsFunction <- <('f' 'u' 'n' 'c' 't' 'i' 'o' 'n' (!'{' .)* jsBlock)> */
+		func() bool {
+			position690, tokenIndex690, depth690 := position, tokenIndex, depth
+			{
+				position691 := position
+				depth++
+				if buffer[position] != rune('f') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l690
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l690
+				}
+				position++
+			l692:
+				{
+					position693, tokenIndex693, depth693 := position, tokenIndex, depth
+					{
+						position694, tokenIndex694, depth694 := position, tokenIndex, depth
+						if buffer[position] != rune('{') {
+							goto l694
+						}
+						position++
+						goto l693
+					l694:
+						position, tokenIndex, depth = position694, tokenIndex694, depth694
+					}
+					if !matchDot() {
+						goto l693
+					}
+					goto l692
+				l693:
+					position, tokenIndex, depth = position693, tokenIndex693, depth693
+				}
+				if !_rules[rulejsBlock]() {
+					goto l690
+				}
+				depth--
+				add(rulejsFunction, position691)
+			}
+			return true
+		l690:
+			position, tokenIndex, depth = position690, tokenIndex690, depth690
+			return false
+		},
+		/* 96 jsBlock <- <('{' (jsString / jsBlock / (!((&('\'') '\'') | (&('"') '"') | (&('}') '}') | (&('{') '{')) .))* '}')> */
+		func() bool {
+			position695, tokenIndex695, depth695 := position, tokenIndex, depth
+			{
+				position696 := position
+				depth++
+				if buffer[position] != rune('{') {
+					goto l695
+				}
+				position++
+			l697:
+				{
+					position698, tokenIndex698, depth698 := position, tokenIndex, depth
+					{
+						position699, tokenIndex699, depth699 := position, tokenIndex, depth
+						{
+							position701 := position
+							depth++
 							{
-								position387 := position
-								depth++
+								position702, tokenIndex702, depth702 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l304
+									goto l703
 								}
 								position++
+							l704:
 								{
-									position388 := position
-									depth++
-								l389:
+									position705, tokenIndex705, depth705 := position, tokenIndex, depth
 									{
-										position390, tokenIndex390, depth390 := position, tokenIndex, depth
+										position706, tokenIndex706, depth706 := position, tokenIndex, depth
 										{
-											position391 := position
-											depth++
+											position708, tokenIndex708, depth708 := position, tokenIndex, depth
 											{
-												position392, tokenIndex392, depth392 := position, tokenIndex, depth
-												{
-													position394, tokenIndex394, depth394 := position, tokenIndex, depth
-													{
-														position395, tokenIndex395, depth395 := position, tokenIndex, depth
-														if buffer[position] != rune('"') {
-															goto l396
-														}
-														position++
-														goto l395
-													l396:
-														position, tokenIndex, depth = position395, tokenIndex395, depth395
-														if buffer[position] != rune('\\') {
-															goto l394
-														}
-														position++
-													}
-												l395:
-													goto l393
-												l394:
-													position, tokenIndex, depth = position394, tokenIndex394, depth394
-												}
-												if !matchDot() {
-													goto l393
+												position709, tokenIndex709, depth709 := position, tokenIndex, depth
+												if buffer[position] != rune('"') {
+													goto l710
 												}
-												goto l392
-											l393:
-												position, tokenIndex, depth = position392, tokenIndex392, depth392
+												position++
+												goto l709
+											l710:
+												position, tokenIndex, depth = position709, tokenIndex709, depth709
 												if buffer[position] != rune('\\') {
-													goto l390
+													goto l708
 												}
 												position++
-												if !matchDot() {
-													goto l390
-												}
-											}
-										l392:
-											depth--
-											add(rulestringChar, position391)
-										}
-										goto l389
-									l390:
-										position, tokenIndex, depth = position390, tokenIndex390, depth390
-									}
-									depth--
-									add(rulePegText, position388)
-								}
-								if buffer[position] != rune('"') {
-									goto l304
-								}
-								position++
-								{
-									add(ruleAction37, position)
-								}
-								depth--
-								add(ruleString, position387)
-							}
-							break
-						case 'f', 't':
-							{
-								position398 := position
-								depth++
-								{
-									position399, tokenIndex399, depth399 := position, tokenIndex, depth
-									{
-										position401 := position
-										depth++
-										if buffer[position] != rune('t') {
-											goto l400
-										}
-										position++
-										if buffer[position] != rune('r') {
-											goto l400
-										}
-										position++
-										if buffer[position] != rune('u') {
-											goto l400
-										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l400
-										}
-										position++
-										{
-											add(ruleAction39, position)
-										}
-										depth--
-										add(ruleTrue, position401)
-									}
-									goto l399
-								l400:
-									position, tokenIndex, depth = position399, tokenIndex399, depth399
-									{
-										position403 := position
-										depth++
-										if buffer[position] != rune('f') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('a') {
-											goto l304
-										}
-										position++
-										if buffer[position] != rune('l') {
-											goto l304
+											}
+										l709:
+											goto l707
+										l708:
+											position, tokenIndex, depth = position708, tokenIndex708, depth708
 										}
-										position++
-										if buffer[position] != rune('s') {
-											goto l304
+										if !matchDot() {
+											goto l707
 										}
-										position++
-										if buffer[position] != rune('e') {
-											goto l304
+										goto l706
+									l707:
+										position, tokenIndex, depth = position706, tokenIndex706, depth706
+										if buffer[position] != rune('\\') {
+											goto l705
 										}
 										position++
-										{
-											add(ruleAction40, position)
+										if !matchDot() {
+											goto l705
 										}
-										depth--
-										add(ruleFalse, position403)
 									}
+								l706:
+									goto l704
+								l705:
+									position, tokenIndex, depth = position705, tokenIndex705, depth705
 								}
-							l399:
-								depth--
-								add(ruleBoolean, position398)
-							}
-							break
-						case '[':
-							{
-								position405 := position
-								depth++
-								if buffer[position] != rune('[') {
-									goto l304
+								if buffer[position] != rune('"') {
+									goto l703
 								}
 								position++
-								{
-									add(ruleAction32, position)
+								goto l702
+							l703:
+								position, tokenIndex, depth = position702, tokenIndex702, depth702
+								if buffer[position] != rune('\'') {
+									goto l700
 								}
+								position++
+							l711:
 								{
-									position407, tokenIndex407, depth407 := position, tokenIndex, depth
+									position712, tokenIndex712, depth712 := position, tokenIndex, depth
 									{
-										position409 := position
-										depth++
-										if !_rules[ruleListElem]() {
-											goto l407
-										}
-									l410:
+										position713, tokenIndex713, depth713 := position, tokenIndex, depth
 										{
-											position411, tokenIndex411, depth411 := position, tokenIndex, depth
-											if buffer[position] != rune(',') {
-												goto l411
-											}
-											position++
-											if !_rules[ruleListElem]() {
-												goto l411
+											position715, tokenIndex715, depth715 := position, tokenIndex, depth
+											{
+												position716, tokenIndex716, depth716 := position, tokenIndex, depth
+												if buffer[position] != rune('\'') {
+													goto l717
+												}
+												position++
+												goto l716
+											l717:
+												position, tokenIndex, depth = position716, tokenIndex716, depth716
+												if buffer[position] != rune('\\') {
+													goto l715
+												}
+												position++
 											}
-											goto l410
-										l411:
-											position, tokenIndex, depth = position411, tokenIndex411, depth411
+										l716:
+											goto l714
+										l715:
+											position, tokenIndex, depth = position715, tokenIndex715, depth715
+										}
+										if !matchDot() {
+											goto l714
+										}
+										goto l713
+									l714:
+										position, tokenIndex, depth = position713, tokenIndex713, depth713
+										if buffer[position] != rune('\\') {
+											goto l712
+										}
+										position++
+										if !matchDot() {
+											goto l712
 										}
-										depth--
-										add(ruleListElements, position409)
 									}
-									goto l408
-								l407:
-									position, tokenIndex, depth = position407, tokenIndex407, depth407
+								l713:
+									goto l711
+								l712:
+									position, tokenIndex, depth = position712, tokenIndex712, depth712
 								}
-							l408:
-								if buffer[position] != rune(']') {
-									goto l304
+								if buffer[position] != rune('\'') {
+									goto l700
 								}
 								position++
-								{
-									add(ruleAction33, position)
-								}
-								depth--
-								add(ruleList, position405)
-							}
-							break
-						case '{':
-							if !_rules[ruleDoc]() {
-								goto l304
 							}
-							break
-						default:
-							if !_rules[ruleNumeric]() {
-								goto l304
+						l702:
+							depth--
+							add(rulejsString, position701)
+						}
+						goto l699
+					l700:
+						position, tokenIndex, depth = position699, tokenIndex699, depth699
+						if !_rules[rulejsBlock]() {
+							goto l718
+						}
+						goto l699
+					l718:
+						position, tokenIndex, depth = position699, tokenIndex699, depth699
+						{
+							position719, tokenIndex719, depth719 := position, tokenIndex, depth
+							{
+								switch buffer[position] {
+								case '\'':
+									if buffer[position] != rune('\'') {
+										goto l719
+									}
+									position++
+									break
+								case '"':
+									if buffer[position] != rune('"') {
+										goto l719
+									}
+									position++
+									break
+								case '}':
+									if buffer[position] != rune('}') {
+										goto l719
+									}
+									position++
+									break
+								default:
+									if buffer[position] != rune('{') {
+										goto l719
+									}
+									position++
+									break
+								}
 							}
-							break
+
+							goto l698
+						l719:
+							position, tokenIndex, depth = position719, tokenIndex719, depth719
+						}
+						if !matchDot() {
+							goto l698
 						}
 					}
-
+				l699:
+					goto l697
+				l698:
+					position, tokenIndex, depth = position698, tokenIndex698, depth698
 				}
-			l306:
+				if buffer[position] != rune('}') {
+					goto l695
+				}
+				position++
 				depth--
-				add(ruleValue, position305)
+				add(rulejsBlock, position696)
 			}
 			return true
-		l304:
-			position, tokenIndex, depth = position304, tokenIndex304, depth304
+		l695:
+			position, tokenIndex, depth = position695, tokenIndex695, depth695
 			return false
 		},
-		/* 56 Numeric <- <(<('-'? [0-9]+ '.'? [0-9]*)> Action36)> */
+		/* 97 jsString <- <(('"' ((!('"' / '\\') .) / ('\\' .))* '"') / ('\'' ((!('\'' / '\\') .) / ('\\' .))* '\''))> */
+		nil,
+		/* 98 refName <- <((('"' <(!'"' .)*> '"') / ('\'' <(!'\'' .)*> '\'')) Action72)> */
 		func() bool {
-			position413, tokenIndex413, depth413 := position, tokenIndex, depth
+			position722, tokenIndex722, depth722 := position, tokenIndex, depth
 			{
-				position414 := position
+				position723 := position
 				depth++
 				{
-					position415 := position
-					depth++
+					position724, tokenIndex724, depth724 := position, tokenIndex, depth
+					if buffer[position] != rune('"') {
+						goto l725
+					}
+					position++
 					{
-						position416, tokenIndex416, depth416 := position, tokenIndex, depth
-						if buffer[position] != rune('-') {
-							goto l416
+						position726 := position
+						depth++
+					l727:
+						{
+							position728, tokenIndex728, depth728 := position, tokenIndex, depth
+							{
+								position729, tokenIndex729, depth729 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l729
+								}
+								position++
+								goto l728
+							l729:
+								position, tokenIndex, depth = position729, tokenIndex729, depth729
+							}
+							if !matchDot() {
+								goto l728
+							}
+							goto l727
+						l728:
+							position, tokenIndex, depth = position728, tokenIndex728, depth728
 						}
-						position++
-						goto l417
-					l416:
-						position, tokenIndex, depth = position416, tokenIndex416, depth416
+						depth--
+						add(rulePegText, position726)
 					}
-				l417:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l413
+					if buffer[position] != rune('"') {
+						goto l725
+					}
+					position++
+					goto l724
+				l725:
+					position, tokenIndex, depth = position724, tokenIndex724, depth724
+					if buffer[position] != rune('\'') {
+						goto l722
 					}
 					position++
-				l418:
 					{
-						position419, tokenIndex419, depth419 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l419
+						position730 := position
+						depth++
+					l731:
+						{
+							position732, tokenIndex732, depth732 := position, tokenIndex, depth
+							{
+								position733, tokenIndex733, depth733 := position, tokenIndex, depth
+								if buffer[position] != rune('\'') {
+									goto l733
+								}
+								position++
+								goto l732
+							l733:
+								position, tokenIndex, depth = position733, tokenIndex733, depth733
+							}
+							if !matchDot() {
+								goto l732
+							}
+							goto l731
+						l732:
+							position, tokenIndex, depth = position732, tokenIndex732, depth732
 						}
-						position++
-						goto l418
-					l419:
-						position, tokenIndex, depth = position419, tokenIndex419, depth419
+						depth--
+						add(rulePegText, position730)
+					}
+					if buffer[position] != rune('\'') {
+						goto l722
 					}
+					position++
+				}
+			l724:
+				{
+					add(ruleAction72, position)
+				}
+				depth--
+				add(rulerefName, position723)
+			}
+			return true
+		l722:
+			position, tokenIndex, depth = position722, tokenIndex722, depth722
+			return false
+		},
+		/* 99 refId <- <((('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')') / <hexChar+>) Action73)> */
+		nil,
+		/* 100 regexChar <- <(!'/' .)> */
+		nil,
+		/* 101 regexBody <- <(regexChar+ '/' ((&('s') 's') | (&('m') 'm') | (&('i') 'i') | (&('g') 'g'))*)> */
+		nil,
+		/* 102 stringChar <- <((!('"' / '\\') .) / ('\\' .))> */
+		func() bool {
+			position738, tokenIndex738, depth738 := position, tokenIndex, depth
+			{
+				position739 := position
+				depth++
+				{
+					position740, tokenIndex740, depth740 := position, tokenIndex, depth
 					{
-						position420, tokenIndex420, depth420 := position, tokenIndex, depth
-						if buffer[position] != rune('.') {
-							goto l420
+						position742, tokenIndex742, depth742 := position, tokenIndex, depth
+						{
+							position743, tokenIndex743, depth743 := position, tokenIndex, depth
+							if buffer[position] != rune('"') {
+								goto l744
+							}
+							position++
+							goto l743
+						l744:
+							position, tokenIndex, depth = position743, tokenIndex743, depth743
+							if buffer[position] != rune('\\') {
+								goto l742
+							}
+							position++
 						}
-						position++
-						goto l421
-					l420:
-						position, tokenIndex, depth = position420, tokenIndex420, depth420
+					l743:
+						goto l741
+					l742:
+						position, tokenIndex, depth = position742, tokenIndex742, depth742
+					}
+					if !matchDot() {
+						goto l741
 					}
-				l421:
-				l422:
+					goto l740
+				l741:
+					position, tokenIndex, depth = position740, tokenIndex740, depth740
+					if buffer[position] != rune('\\') {
+						goto l738
+					}
+					position++
+					if !matchDot() {
+						goto l738
+					}
+				}
+			l740:
+				depth--
+				add(rulestringChar, position739)
+			}
+			return true
+		l738:
+			position, tokenIndex, depth = position738, tokenIndex738, depth738
+			return false
+		},
+		/* 103 fieldName <- <(fieldNameChar+ (' '+ fieldNameChar+)*)> */
+		nil,
+		/* 104 fieldNameChar <- <(!((&('\n') '\n') | (&('\r') '\r') | (&('\t') '\t') | (&(' ') ' ') | (&('"') '"') | (&(']') ']') | (&('[') '[') | (&('}') '}') | (&('{') '{') | (&(',') ',') | (&(':') ':')) .)> */
+		func() bool {
+			position746, tokenIndex746, depth746 := position, tokenIndex, depth
+			{
+				position747 := position
+				depth++
+				{
+					position748, tokenIndex748, depth748 := position, tokenIndex, depth
 					{
-						position423, tokenIndex423, depth423 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l423
+						switch buffer[position] {
+						case '\n':
+							if buffer[position] != rune('\n') {
+								goto l748
+							}
+							position++
+							break
+						case '\r':
+							if buffer[position] != rune('\r') {
+								goto l748
+							}
+							position++
+							break
+						case '\t':
+							if buffer[position] != rune('\t') {
+								goto l748
+							}
+							position++
+							break
+						case ' ':
+							if buffer[position] != rune(' ') {
+								goto l748
+							}
+							position++
+							break
+						case '"':
+							if buffer[position] != rune('"') {
+								goto l748
+							}
+							position++
+							break
+						case ']':
+							if buffer[position] != rune(']') {
+								goto l748
+							}
+							position++
+							break
+						case '[':
+							if buffer[position] != rune('[') {
+								goto l748
+							}
+							position++
+							break
+						case '}':
+							if buffer[position] != rune('}') {
+								goto l748
+							}
+							position++
+							break
+						case '{':
+							if buffer[position] != rune('{') {
+								goto l748
+							}
+							position++
+							break
+						case ',':
+							if buffer[position] != rune(',') {
+								goto l748
+							}
+							position++
+							break
+						default:
+							if buffer[position] != rune(':') {
+								goto l748
+							}
+							position++
+							break
 						}
-						position++
-						goto l422
-					l423:
-						position, tokenIndex, depth = position423, tokenIndex423, depth423
 					}
-					depth--
-					add(rulePegText, position415)
+
+					goto l746
+				l748:
+					position, tokenIndex, depth = position748, tokenIndex748, depth748
 				}
-				{
-					add(ruleAction36, position)
+				if !matchDot() {
+					goto l746
 				}
 				depth--
-				add(ruleNumeric, position414)
+				add(rulefieldNameChar, position747)
 			}
 			return true
-		l413:
-			position, tokenIndex, depth = position413, tokenIndex413, depth413
+		l746:
+			position, tokenIndex, depth = position746, tokenIndex746, depth746
 			return false
 		},
-		/* 57 Boolean <- <(True / False)> */
 		nil,
-		/* 58 String <- <('"' <stringChar*> '"' Action37)> */
+		/* 107 Action0 <- <{ p.SetField("severity", p.text(buffer, begin, end)); p.SetVersion(Version30) }> */
 		nil,
-		/* 59 Null <- <('n' 'u' 'l' 'l' Action38)> */
+		/* 108 Action1 <- <{ p.SetField("component", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 60 True <- <('t' 'r' 'u' 'e' Action39)> */
+		/* 109 Action2 <- <{ p.SetField("context", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 61 False <- <('f' 'a' 'l' 's' 'e' Action40)> */
+		/* 110 Action3 <- <{ p.SetField("op", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 62 Date <- <(('n' 'e' 'w' ' ')? ('D' 'a' 't' 'e' '(') '-'? <[0-9]+> ')' Action41)> */
+		/* 111 Action4 <- <{ p.SetField("warning", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 63 ObjectID <- <('O' 'b' 'j' 'e' 'c' 't' 'I' 'd' '(' ('\'' / '"') <hexChar*> ('\'' / '"') ')' Action42)> */
+		/* 112 Action5 <- <{ p.SetField("ns", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 64 BinData <- <('B' 'i' 'n' 'D' 'a' 't' 'a' '(' <(!')' .)+> ')' Action43)> */
+		/* 113 Action6 <- <{ p.StartField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 65 Regex <- <('/' <regexBody> Action44)> */
+		/* 114 Action7 <- <{ p.EndField() }> */
 		nil,
-		/* 66 TimestampVal <- <(timestampParen / timestampPipe)> */
+		/* 115 Action8 <- <{ p.SetField("duration_ms", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 67 timestampParen <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' '(' <(!')' .)+> ')' Action45)> */
+		/* 116 Action9 <- <{ p.StartField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 68 timestampPipe <- <('T' 'i' 'm' 'e' 's' 't' 'a' 'm' 'p' ' ' <([0-9] / '|')+> Action46)> */
+		/* 117 Action10 <- <{ p.EndField() }> */
 		nil,
-		/* 69 NumberLong <- <('N' 'u' 'm' 'b' 'e' 'r' 'L' 'o' 'n' 'g' '(' <(!')' .)+> ')' Action47)> */
+		/* 118 Action11 <- <{ p.SetField("command_type", p.text(buffer, begin, end)); p.StartField("command") }> */
 		nil,
-		/* 70 MinKey <- <('M' 'i' 'n' 'K' 'e' 'y' Action48)> */
+		/* 119 Action12 <- <{ p.EndField() }> */
 		nil,
-		/* 71 MaxKey <- <('M' 'a' 'x' 'K' 'e' 'y' Action49)> */
+		/* 120 Action13 <- <{ p.SetField("protocol", p.text(buffer, begin, end)) }> */
 		nil,
-		/* 72 Undefined <- <('u' 'n' 'd' 'e' 'f' 'i' 'n' 'e' 'd' Action50)> */
+		/* 121 Action14 <- <{ p.StartField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 73 hexChar <- <([0-9] / ([a-f] / [A-F]))> */
+		/* 122 Action15 <- <{ p.PushValue(p.text(buffer, begin, end)); p.EndField() }> */
 		nil,
-		/* 74 regexChar <- <(!'/' .)> */
+		/* 123 Action16 <- <{ p.StartField("planSummary"); p.PushList() }> */
 		nil,
-		/* 75 regexBody <- <(regexChar+ '/' ((&('s') 's') | (&('m') 'm') | (&('i') 'i') | (&('g') 'g'))*)> */
+		/* 124 Action17 <- <{ p.EndField()}> */
 		nil,
-		/* 76 stringChar <- <((!('"' / '\\') .) / ('\\' .))> */
+		/* 125 Action18 <- <{ p.PushMap(); p.PushField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 77 fieldChar <- <((&('$' | '*' | '.' | '_') ((&('*') '*') | (&('.') '.') | (&('$') '$') | (&('_') '_'))) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
-		func() bool {
-			position445, tokenIndex445, depth445 := position, tokenIndex, depth
-			{
-				position446 := position
-				depth++
-				{
-					switch buffer[position] {
-					case '$', '*', '.', '_':
-						{
-							switch buffer[position] {
-							case '*':
-								if buffer[position] != rune('*') {
-									goto l445
-								}
-								position++
-								break
-							case '.':
-								if buffer[position] != rune('.') {
-									goto l445
-								}
-								position++
-								break
-							case '$':
-								if buffer[position] != rune('$') {
-									goto l445
-								}
-								position++
-								break
-							default:
-								if buffer[position] != rune('_') {
-									goto l445
-								}
-								position++
-								break
-							}
-						}
-
-						break
-					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l445
-						}
-						position++
-						break
-					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l445
-						}
-						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l445
-						}
-						position++
-						break
-					}
-				}
-
-				depth--
-				add(rulefieldChar, position446)
-			}
-			return true
-		l445:
-			position, tokenIndex, depth = position445, tokenIndex445, depth445
-			return false
-		},
+		/* 126 Action19 <- <{ p.SetMapValue(); p.SetListValue() }> */
+		nil,
+		/* 127 Action20 <- <{ p.PushValue(1); p.SetMapValue(); p.SetListValue() }> */
+		nil,
+		/* 128 Action21 <- <{ p.PushList() }> */
+		nil,
+		/* 129 Action22 <- <{ p.PopList() }> */
 		nil,
-		/* 80 Action0 <- <{ p.SetField("severity", buffer[begin:end]) }> */
+		/* 130 Action23 <- <{ p.PushMap() }> */
 		nil,
-		/* 81 Action1 <- <{ p.SetField("component", buffer[begin:end]) }> */
+		/* 131 Action24 <- <{ p.SetMapValue(); p.SetListValue() }> */
 		nil,
-		/* 82 Action2 <- <{ p.SetField("context", buffer[begin:end]) }> */
+		/* 132 Action25 <- <{ p.PopMap() }> */
 		nil,
-		/* 83 Action3 <- <{ p.SetField("op", buffer[begin:end]) }> */
+		/* 133 Action26 <- <{ p.StartField("exception") }> */
 		nil,
-		/* 84 Action4 <- <{ p.SetField("warning", buffer[begin:end]) }> */
+		/* 134 Action27 <- <{ p.PushValue(p.text(buffer, begin, end)); p.EndField() }> */
 		nil,
-		/* 85 Action5 <- <{ p.SetField("ns", buffer[begin:end]) }> */
+		/* 135 Action28 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 86 Action6 <- <{ p.StartField(buffer[begin:end]) }> */
+		/* 136 Action29 <- <{ p.SetField("timestamp", p.text(buffer, begin, end)); p.SetVersion(Version24) }> */
 		nil,
-		/* 87 Action7 <- <{ p.EndField() }> */
+		/* 137 Action30 <- <{ p.SetField("timestamp", p.text(buffer, begin, end)); p.SetVersion(Version26) }> */
 		nil,
-		/* 88 Action8 <- <{ p.SetField("duration_ms", buffer[begin:end]) }> */
+		/* 138 Action31 <- <{ p.SetField(ExtraField, p.text(buffer, begin, end)) }> */
 		nil,
-		/* 89 Action9 <- <{ p.StartField(buffer[begin:end]) }> */
+		/* 139 Action32 <- <{ p.PushMap() }> */
 		nil,
-		/* 90 Action10 <- <{ p.EndField() }> */
+		/* 140 Action33 <- <{ p.PopMap() }> */
 		nil,
-		/* 91 Action11 <- <{ p.SetField("command_type", buffer[begin:end]); p.StartField("command") }> */
+		/* 141 Action34 <- <{ p.SetMapValue() }> */
 		nil,
-		/* 92 Action12 <- <{ p.EndField() }> */
+		/* 142 Action35 <- <{ p.PushList() }> */
 		nil,
-		/* 93 Action13 <- <{ p.StartField("planSummary"); p.PushList() }> */
+		/* 143 Action36 <- <{ p.PopList() }> */
 		nil,
-		/* 94 Action14 <- <{ p.EndField()}> */
+		/* 144 Action37 <- <{ p.SetListValue() }> */
 		nil,
-		/* 95 Action15 <- <{ p.PushMap(); p.PushField(buffer[begin:end]) }> */
+		/* 145 Action38 <- <{ p.PushField(p.Unescape(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 96 Action16 <- <{ p.SetMapValue(); p.SetListValue() }> */
+		/* 146 Action39 <- <{ p.PushField(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 97 Action17 <- <{ p.PushValue(1); p.SetMapValue(); p.SetListValue() }> */
+		/* 147 Action40 <- <{ p.PushValue(p.Numeric(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 98 Action18 <- <{ p.PushList() }> */
+		/* 148 Action41 <- <{ p.PushValue(p.Unescape(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 99 Action19 <- <{ p.PopList() }> */
+		/* 149 Action42 <- <{ p.PushValue(nil) }> */
 		nil,
-		/* 100 Action20 <- <{ p.PushMap() }> */
+		/* 150 Action43 <- <{ p.PushValue(true) }> */
 		nil,
-		/* 101 Action21 <- <{ p.SetMapValue(); p.SetListValue() }> */
+		/* 151 Action44 <- <{ p.PushValue(false) }> */
 		nil,
-		/* 102 Action22 <- <{ p.PopMap() }> */
+		/* 152 Action45 <- <{ p.PushValue(p.Date(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 103 Action23 <- <{ p.StartField("exception") }> */
+		/* 153 Action46 <- <{ p.PushValue(p.ISODate(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 104 Action24 <- <{ p.PushValue(buffer[begin:end]); p.EndField() }> */
+		/* 154 Action47 <- <{ p.PushValue(p.ObjectId(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 105 Action25 <- <{ p.PushValue(buffer[begin:end]) }> */
+		/* 155 Action48 <- <{ p.PushValue(p.Bindata(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 106 Action26 <- <{ p.SetField("timestamp", buffer[begin:end]) }> */
+		/* 156 Action49 <- <{ p.PushValue(p.Uuid(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 107 Action27 <- <{ p.SetField("timestamp", buffer[begin:end]) }> */
+		/* 157 Action50 <- <{ p.PushValue(p.Hexdata(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 108 Action28 <- <{ p.SetField("xextra", buffer[begin:end]) }> */
+		/* 158 Action51 <- <{ p.PushValue(p.Regex(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 109 Action29 <- <{ p.PushMap() }> */
+		/* 159 Action52 <- <{ p.PushValue(p.Timestamp(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 110 Action30 <- <{ p.PopMap() }> */
+		/* 160 Action53 <- <{ p.PushValue(p.Timestamp(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 111 Action31 <- <{ p.SetMapValue() }> */
+		/* 161 Action54 <- <{ p.PushValue(p.Numberlong(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 112 Action32 <- <{ p.PushList() }> */
+		/* 162 Action55 <- <{ p.PushValue(p.Numberint(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 113 Action33 <- <{ p.PopList() }> */
+		/* 163 Action56 <- <{ p.PushValue(p.Numberdecimal(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 114 Action34 <- <{ p.SetListValue() }> */
+		/* 164 Action57 <- <{ p.PushValue(p.Javascript(p.text(buffer, begin, end))) }> */
 		nil,
-		/* 115 Action35 <- <{ p.PushField(buffer[begin:end]) }> */
+		/* 165 Action58 <- <{ p.PushValue(p.Javascript(p.PopValue().(string))) }> */
 		nil,
-		/* 116 Action36 <- <{ p.PushValue(p.Numeric(buffer[begin:end])) }> */
+		/* 166 Action59 <- <{ p.PushValue(p.CodeWScope()) }> */
 		nil,
-		/* 117 Action37 <- <{ p.PushValue(buffer[begin:end]) }> */
+		/* 167 Action60 <- <{ p.PushValue(p.DBRef(true)) }> */
 		nil,
-		/* 118 Action38 <- <{ p.PushValue(nil) }> */
+		/* 168 Action61 <- <{ p.PushValue(p.DBRef(false)) }> */
 		nil,
-		/* 119 Action39 <- <{ p.PushValue(true) }> */
+		/* 169 Action62 <- <{ p.PushValue(p.DBPointer()) }> */
 		nil,
-		/* 120 Action40 <- <{ p.PushValue(false) }> */
+		/* 170 Action63 <- <{ p.PushValue(p.Symbol(p.PopValue().(string))) }> */
 		nil,
-		/* 121 Action41 <- <{ p.PushValue(p.Date(buffer[begin:end])) }> */
+		/* 171 Action64 <- <{ p.PushValue(p.text(buffer, begin, end)); p.PushList() }> */
 		nil,
-		/* 122 Action42 <- <{ p.PushValue(p.ObjectId(buffer[begin:end])) }> */
+		/* 172 Action65 <- <{ p.SetListValue() }> */
 		nil,
-		/* 123 Action43 <- <{ p.PushValue(p.Bindata(buffer[begin:end])) }> */
+		/* 173 Action66 <- <{ p.SetListValue() }> */
 		nil,
-		/* 124 Action44 <- <{ p.PushValue(p.Regex(buffer[begin:end])) }> */
+		/* 174 Action67 <- <{ p.PopList(); p.PushValue(p.Constructor()) }> */
 		nil,
-		/* 125 Action45 <- <{ p.PushValue(p.Timestamp(buffer[begin:end])) }> */
+		/* 175 Action68 <- <{ p.PushValue(p.Minkey()) }> */
 		nil,
-		/* 126 Action46 <- <{ p.PushValue(p.Timestamp(buffer[begin:end])) }> */
+		/* 176 Action69 <- <{ p.PushValue(p.Maxkey()) }> */
 		nil,
-		/* 127 Action47 <- <{ p.PushValue(p.Numberlong(buffer[begin:end])) }> */
+		/* 177 Action70 <- <{ p.PushValue(p.Undefined()) }> */
 		nil,
-		/* 128 Action48 <- <{ p.PushValue(p.Minkey()) }> */
+		/* 178 Action71 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 129 Action49 <- <{ p.PushValue(p.Maxkey()) }> */
+		/* 179 Action72 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
-		/* 130 Action50 <- <{ p.PushValue(p.Undefined()) }> */
+		/* 180 Action73 <- <{ p.PushValue(p.text(buffer, begin, end)) }> */
 		nil,
 	}
 	p.rules = _rules
@@ -0,0 +1,120 @@
+package logline
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+)
+
+func TestCommandMetadata(t *testing.T) {
+	line := `2019-03-14T12:00:00.000+0000 I COMMAND  [conn42] command test.users appName: "MongoDB Shell" command: find { find: "users", filter: { name: "bob" }, $db: "test" } planSummary: IXSCAN { name: 1 } keysExamined:1 docsExamined:1 cursorExhausted:1 numYields:0 nreturned:1 queryHash:4B53BE76 planCacheKey:0AE4F5C1 reslen:233 locks:{ Global: { acquireCount: { r: 1 } } } storage:{} protocol:op_msg planningTimeMicros:85 12ms`
+	record, err := ParseLogLine(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"timestamp":          "2019-03-14T12:00:00.000+0000",
+		"severity":           "I",
+		"component":          "COMMAND",
+		"context":            "conn42",
+		"op":                 "command",
+		"ns":                 "test.users",
+		"appName":            "MongoDB Shell",
+		"command_type":       "find",
+		"command":            map[string]interface{}{"find": "users", "filter": map[string]interface{}{"name": "bob"}, "$db": "test"},
+		"planSummary":        []interface{}{map[string]interface{}{"IXSCAN": []interface{}{map[string]interface{}{"name": int64(1)}}}},
+		"keysExamined":       int64(1),
+		"docsExamined":       int64(1),
+		"cursorExhausted":    int64(1),
+		"numYields":          int64(0),
+		"nreturned":          int64(1),
+		"queryHash":          "4B53BE76",
+		"planCacheKey":       "0AE4F5C1",
+		"reslen":             int64(233),
+		"locks":              map[string]interface{}{"Global": map[string]interface{}{"acquireCount": map[string]interface{}{"r": int64(1)}}},
+		"storage":            map[string]interface{}{},
+		"protocol":           "op_msg",
+		"planningTimeMicros": int64(85),
+		"duration_ms":        "12",
+		VersionField:         Version32,
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf("expected %v\nbut got %v", expected, record)
+	}
+
+	// protocol values may end the line and hashes may start with a digit
+	record, err = ParseLogLine(`2016-02-10T10:17:46.371+0000 I COMMAND  [conn1] command test.users command: count { count: "users" } planSummary: COUNT queryHash:0FF1CE00 protocol:op_query`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["protocol"] != "op_query" || record["queryHash"] != "0FF1CE00" || record["xextra"] != nil {
+		t.Errorf("unexpected record %v", record)
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	record, err := ParseLogLine(`2015-03-17T18:09:44.574+0000 I QUERY    [conn1] query test.users query: { "first name": "José", address-line: "Straße 1", 名前: 1 } planSummary: COLLSCAN ntoreturn:0 nscanned:0 nscannedObjects:3 nreturned:1 reslen:52 locks:{} 250ms`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"first name": "José", "address-line": "Straße 1", "名前": int64(1)}
+	if !reflect.DeepEqual(record["query"], expected) {
+		t.Errorf("expected query %v, got %v", expected, record["query"])
+	}
+	if record["planSummary"] == nil || record["nreturned"] != int64(1) || record["duration_ms"] != "250" {
+		t.Errorf("expected the fields following the query to be parsed, got %v", record)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	line := `Mon Feb 23 03:20:19.670 [conn1] query test.users query: { a: { b: [ 1 ] } } ntoreturn:0 nreturned:0 reslen:20 0ms`
+
+	record, err := ParseLogLineOptions(line, Options{KeepRaw: true, Year: 2015})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record[RawField] != line || record["timestamp"] != "2015-02-23T03:20:19.670" {
+		t.Errorf("unexpected record %v", record)
+	}
+	// timestamps that carry a year are left alone
+	record, _ = ParseLogLineOptions(`2015-02-23T03:20:19.670+0000 [conn1] query test.users query: {} 0ms`, Options{Year: 2001})
+	if record["timestamp"] != "2015-02-23T03:20:19.670+0000" {
+		t.Errorf("unexpected timestamp %v", record["timestamp"])
+	}
+
+	// query, a and b are three levels deep
+	if _, err := ParseLogLineOptions(line, Options{Options: logdoc.Options{MaxDepth: 3}}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := ParseLogLineOptions(line, Options{Options: logdoc.Options{MaxDepth: 2}}); err == nil {
+		t.Error("expected an error for a document nested too deep")
+	}
+
+	record, err = ParseLogLineOptions(`2015-02-23T03:20:19.670+0000 [conn1] query test.users query: { d: new Date(0) } 0ms`, Options{Options: logdoc.Options{Types: logdoc.TypesNative}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, ok := record["query"].(map[string]interface{})["d"].(time.Time); !ok || d.Unix() != 0 {
+		t.Errorf("expected a time.Time, got %#v", record["query"])
+	}
+
+	extra := `2015-02-23T03:20:19.670+0000 I NETWORK  [conn1] end connection 127.0.0.1:53245 (1 connection now open)`
+	if record, err := ParseLogLine(extra); err != nil || record[ExtraField] == nil {
+		t.Fatalf("expected unparsed text, got %v %v", record, err)
+	}
+	if _, err := ParseLogLineOptions(extra, Options{Strict: true}); err == nil {
+		t.Error("expected an error for unparsed text in strict mode")
+	}
+}
+
+func TestJSONNumbers(t *testing.T) {
+	record, err := ParseLogLine(`{"t":{"$date":"2020-08-05T12:00:00.000+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"ns":"test.users","n":2,"ratio":2.0,"half":0.5,"durationMillis":120}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["n"] != int64(2) || record["ratio"] != logdoc.Double(2) || record["half"] != 0.5 {
+		t.Errorf("unexpected numbers %#v %#v %#v", record["n"], record["ratio"], record["half"])
+	}
+}
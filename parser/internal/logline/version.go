@@ -0,0 +1,95 @@
+package logline
+
+import "fmt"
+
+// Log format generations recognized by the parser. Version32 covers the
+// 3.2 through 4.2 text format and Version44 the structured json format.
+const (
+	Version24 = "2.4"
+	Version26 = "2.6"
+	Version30 = "3.0"
+	Version32 = "3.2"
+	Version44 = "4.4"
+)
+
+// Versions lists the log format generations from oldest to newest.
+var Versions = []string{Version24, Version26, Version30, Version32, Version44}
+
+// VersionField is the record field holding the detected format generation.
+const VersionField = "log_version"
+
+// fields only written by 3.2 and later servers
+var fields32 = []string{"keysExamined", "docsExamined", "protocol", "appName", "planCacheKey", "queryHash", "planningTimeMicros", "cursorExhausted"}
+
+// fields only written by 3.0 and earlier servers
+var fields30 = []string{"nscanned", "nscannedObjects"}
+
+// ErrVersionMismatch is returned when a line can't have been written in the pinned format.
+type ErrVersionMismatch struct {
+	Version  string
+	Detected string
+}
+
+func (e *ErrVersionMismatch) Error() string {
+	return fmt.Sprintf("log_line: expected a %s line, detected %s", e.Version, e.Detected)
+}
+
+func (m *logLine) SetVersion(version string) {
+	m.version = version
+}
+
+// versions returns the format generations that could have produced the
+// parsed fields. Severity prefixed lines without any fields specific to 3.0
+// or 3.2 are ambiguous between the two.
+func versions(version string, fields map[string]interface{}) []string {
+	if version != Version30 {
+		return []string{version}
+	}
+	for _, f := range fields32 {
+		if _, ok := fields[f]; ok {
+			return []string{Version32}
+		}
+	}
+	if fields["op"] == "command" && fields["command_type"] == "find" {
+		return []string{Version32}
+	}
+	for _, f := range fields30 {
+		if _, ok := fields[f]; ok {
+			return []string{Version30}
+		}
+	}
+	return []string{Version30, Version32}
+}
+
+// ValidVersion reports whether version names a known format generation.
+func ValidVersion(version string) bool {
+	return versionIndex(version) >= 0
+}
+
+func versionIndex(version string) int {
+	for i, v := range Versions {
+		if v == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// VersionDetector infers the format generation of a stream of lines.
+type VersionDetector struct {
+	version string
+}
+
+// Observe records the format generation detected for a parsed record.
+func (d *VersionDetector) Observe(record map[string]interface{}) {
+	v, _ := record[VersionField].(string)
+	if versionIndex(v) > versionIndex(d.version) {
+		d.version = v
+	}
+}
+
+// Version returns the newest generation observed so far, as the fields that
+// distinguish a generation don't appear on every line of a stream.
+func (d *VersionDetector) Version() string {
+	return d.version
+}
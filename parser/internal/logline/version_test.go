@@ -0,0 +1,85 @@
+package logline
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetectVersion(t *testing.T) {
+	cases := []struct {
+		line     string
+		versions []string
+	}{
+		{`Mon Feb 23 03:20:19.670 [TTLMonitor] query local.system.indexes query: { expireAfterSeconds: { $exists: true } } ntoreturn:0 ntoskip:0 nscanned:0 keyUpdates:0 locks(micros) r:86 nreturned:0 reslen:20 0ms`, []string{Version24}},
+		{`2014-11-03T18:28:32.450+0000 [conn1] query test.users query: { name: "bob" } planSummary: COLLSCAN ntoreturn:0 ntoskip:0 nscanned:3 nscannedObjects:3 keyUpdates:0 numYields:0 locks(micros) r:110 nreturned:1 reslen:52 0ms`, []string{Version26}},
+		{`2015-03-17T18:09:44.574+0000 I QUERY    [conn1] query test.users query: { name: "bob" } planSummary: COLLSCAN ntoreturn:0 ntoskip:0 nscanned:0 nscannedObjects:3 keyUpdates:0 writeConflicts:0 numYields:0 nreturned:1 reslen:52 locks:{} 0ms`, []string{Version30}},
+		{`2016-02-10T10:17:46.371+0000 I COMMAND  [conn1] command test.users command: find { find: "users", filter: { name: "bob" } } planSummary: COLLSCAN keysExamined:0 docsExamined:3 cursorExhausted:1 keyUpdates:0 writeConflicts:0 numYields:0 nreturned:1 reslen:52 locks:{} protocol:op_command 0ms`, []string{Version32}},
+		{`2016-02-10T10:17:46.371+0000 I NETWORK  [conn1] end connection 127.0.0.1:53245 (1 connection now open)`, []string{Version30, Version32}},
+		{`{"t":{"$date":"2020-05-20T19:18:40.604+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"test.users","command":{"find":"users","filter":{"name":"bob"},"$db":"test"},"planSummary":"COLLSCAN","keysExamined":0,"docsExamined":3,"nreturned":1,"reslen":232,"durationMillis":12}}`, []string{Version44}},
+	}
+	for i, testcase := range cases {
+		for _, version := range append([]string{""}, Versions...) {
+			record, err := ParseLogLineVersion(testcase.line, version)
+			accepted := version == ""
+			for _, v := range testcase.versions {
+				accepted = accepted || v == version
+			}
+			if !accepted {
+				if _, ok := err.(*ErrVersionMismatch); !ok {
+					t.Errorf("case %d: expected a version mismatch pinning %s, got %v", i, version, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("case %d: error parsing as %q: %v", i, version, err)
+			}
+			expected := testcase.versions[0]
+			if version != "" {
+				expected = version
+			}
+			if record[VersionField] != expected {
+				t.Errorf("case %d: expected version %s pinning %q, got %v", i, expected, version, record[VersionField])
+			}
+		}
+	}
+}
+
+func TestParseJSONLine(t *testing.T) {
+	record, err := ParseLogLine(`{"t":{"$date":"2020-05-20T19:18:40.604+00:00"},"s":"I","c":"COMMAND","id":51803,"ctx":"conn1","msg":"Slow query","attr":{"type":"command","ns":"test.users","command":{"find":"users","filter":{"age":{"$gt":21.5}}},"nreturned":1,"durationMillis":12}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"timestamp":    "2020-05-20T19:18:40.604+00:00",
+		"severity":     "I",
+		"component":    "COMMAND",
+		"context":      "conn1",
+		"id":           int64(51803),
+		"msg":          "Slow query",
+		"op":           "command",
+		"ns":           "test.users",
+		"command_type": "find",
+		"command":      map[string]interface{}{"find": "users", "filter": map[string]interface{}{"age": map[string]interface{}{"$gt": 21.5}}},
+		"nreturned":    int64(1),
+		"duration_ms":  "12",
+		VersionField:   Version44,
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf("expected %v\nbut got %v", expected, record)
+	}
+	for _, line := range []string{`{"t":`, `{"msg":"no timestamp"}`} {
+		if _, err := ParseLogLine(line); err == nil {
+			t.Errorf("expected an error parsing %s", line)
+		}
+	}
+}
+
+func TestVersionDetector(t *testing.T) {
+	var d VersionDetector
+	for _, v := range []string{Version30, Version32, Version30, ""} {
+		d.Observe(map[string]interface{}{VersionField: v})
+	}
+	if d.Version() != Version32 {
+		t.Errorf("expected the stream version %s, got %s", Version32, d.Version())
+	}
+}
@@ -2,7 +2,33 @@ package parser
 
 import "github.com/tmc/mongologtools/parser/internal/logdoc"
 
+// Values of the types without an equivalent in github.com/mongodb/mongo-tools/common/json
+type (
+	// Double is a float64 that marshals NaN and the infinities as extended json and integral values with a fraction
+	Double = logdoc.Double
+	// NumberDecimal is a 128 bit decimal value, kept in its string representation
+	NumberDecimal = logdoc.NumberDecimal
+	// Code is JavaScript code with an optional scope
+	Code = logdoc.Code
+	// DBRef is a reference to a document in another collection
+	DBRef = logdoc.DBRef
+	// DBPointer is the deprecated reference type holding a namespace and an ObjectId
+	DBPointer = logdoc.DBPointer
+	// Symbol is the deprecated symbol type
+	Symbol = logdoc.Symbol
+)
+
 // ConvertLogToExtended converts MongoDB log line formatted documents to an extended JSON representation
 func ConvertLogToExtended(input []byte) (map[string]interface{}, error) {
 	return logdoc.ConvertLogToExtended(input)
 }
+
+// FormatLogDoc renders a document in the MongoDB log line document syntax, the inverse of ConvertLogToExtended
+func FormatLogDoc(v interface{}) ([]byte, error) {
+	return logdoc.Format(v)
+}
+
+// FormatLogDocIndent is like FormatLogDoc but writes nested values on separate indented lines
+func FormatLogDocIndent(v interface{}, indent string) ([]byte, error) {
+	return logdoc.FormatIndent(v, indent)
+}
@@ -2,7 +2,36 @@ package parser
 
 import "github.com/tmc/mongologtools/parser/internal/logline"
 
+// Log format generations detected by ParseLogLine. Version32 covers the 3.2
+// through 4.2 text format and Version44 the structured json format.
+const (
+	Version24 = logline.Version24
+	Version26 = logline.Version26
+	Version30 = logline.Version30
+	Version32 = logline.Version32
+	Version44 = logline.Version44
+)
+
+// VersionField is the record field holding the detected format generation
+const VersionField = logline.VersionField
+
+// ErrVersionMismatch is returned by ParseLogLineVersion for lines written in another format
+type ErrVersionMismatch = logline.ErrVersionMismatch
+
+// VersionDetector infers the format generation of a stream of parsed records
+type VersionDetector = logline.VersionDetector
+
 // ParseLogLine attempts to parse a MongoDB log line into a structured representation
 func ParseLogLine(input string) (map[string]interface{}, error) {
 	return logline.ParseLogLine(input)
 }
+
+// ParseLogLineVersion is like ParseLogLine but rejects lines that can't have been written in the given format generation
+func ParseLogLineVersion(input string, version string) (map[string]interface{}, error) {
+	return logline.ParseLogLineVersion(input, version)
+}
+
+// ValidVersion reports whether version names a known log format generation
+func ValidVersion(version string) bool {
+	return logline.ValidVersion(version)
+}
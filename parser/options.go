@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"github.com/tmc/mongologtools/parser/internal/logdoc"
+	"github.com/tmc/mongologtools/parser/internal/logline"
+)
+
+// ValueConverter converts the shell type values of log documents, such as
+// ObjectId(...) or new Date(...), and any other constructor-style value
+type ValueConverter = logdoc.ValueConverter
+
+// ValueConverterFunc adapts a function to a ValueConverter
+type ValueConverterFunc = logdoc.ValueConverterFunc
+
+var (
+	// DefaultConverter converts values to the extended json types and rejects unknown constructors
+	DefaultConverter = logdoc.DefaultConverter
+	// NativeConverter converts values to native go types where there is one, such as time.Time for dates.
+	// The bsonconv package provides a converter to the driver's bson/primitive types.
+	NativeConverter = logdoc.NativeConverter
+)
+
+// Kinds of the shell type values handed to a ValueConverter
+const (
+	KindDate          = logdoc.KindDate
+	KindObjectId      = logdoc.KindObjectId
+	KindBinData       = logdoc.KindBinData
+	KindTimestamp     = logdoc.KindTimestamp
+	KindNumberLong    = logdoc.KindNumberLong
+	KindNumberInt     = logdoc.KindNumberInt
+	KindNumberDecimal = logdoc.KindNumberDecimal
+	KindRegex         = logdoc.KindRegex
+	KindMinKey        = logdoc.KindMinKey
+	KindMaxKey        = logdoc.KindMaxKey
+	KindUndefined     = logdoc.KindUndefined
+	KindCode          = logdoc.KindCode
+	KindDBRef         = logdoc.KindDBRef
+	KindDBPointer     = logdoc.KindDBPointer
+	KindSymbol        = logdoc.KindSymbol
+)
+
+// CheckConverterArgs returns an error unless kind is a shell type and args are of the types passed to a ValueConverter for it
+func CheckConverterArgs(kind string, args []interface{}) error {
+	return logdoc.CheckArgs(kind, args)
+}
+
+// TypeMode selects the types shell type values are converted to
+type TypeMode = logdoc.TypeMode
+
+const (
+	// TypesExtended converts values to the extended json types, the default
+	TypesExtended = logdoc.TypesExtended
+	// TypesNative converts values with NativeConverter
+	TypesNative = logdoc.TypesNative
+)
+
+// RawField is the record field holding the input line when ParseOptions.KeepRaw is set
+const RawField = logline.RawField
+
+// ParseOptions configure ParseLogLineWithOptions and ConvertLogToExtendedWithOptions.
+// The zero value behaves like ParseLogLine and ConvertLogToExtended.
+type ParseOptions struct {
+	// Version, if set, rejects lines that can't have been written in the given format generation
+	Version string
+	// KeepRaw records the input line in the raw field
+	KeepRaw bool
+	// Strict rejects lines with trailing text the grammar couldn't parse instead of recording it in the xextra field
+	Strict bool
+	// Year, if set, places the year-less 2.4 timestamps in the given year,
+	// rewriting them as 2006-01-02T15:04:05.000
+	Year int
+	// Types selects the types shell type values are converted to
+	Types TypeMode
+	// Converter converts shell type values, overriding Types. Setting it
+	// also enables parsing of unknown constructor-style values, which are
+	// handed to it by name.
+	Converter ValueConverter
+	// MaxDepth rejects documents with maps and lists nested deeper than MaxDepth levels, 0 for no limit
+	MaxDepth int
+}
+
+func (o ParseOptions) doc() logdoc.Options {
+	return logdoc.Options{Converter: o.Converter, Types: o.Types, MaxDepth: o.MaxDepth}
+}
+
+// ParseLogLineWithOptions is like ParseLogLine but configured by opts
+func ParseLogLineWithOptions(input string, opts ParseOptions) (map[string]interface{}, error) {
+	return logline.ParseLogLineOptions(input, logline.Options{
+		Options: opts.doc(),
+		Version: opts.Version,
+		KeepRaw: opts.KeepRaw,
+		Strict:  opts.Strict,
+		Year:    opts.Year,
+	})
+}
+
+// ConvertLogToExtendedWithOptions is like ConvertLogToExtended but configured
+// by opts. Only the Types, Converter and MaxDepth options apply to documents.
+func ConvertLogToExtendedWithOptions(input []byte, opts ParseOptions) (map[string]interface{}, error) {
+	return logdoc.ConvertLogToExtendedOptions(input, opts.doc())
+}
@@ -0,0 +1,26 @@
+package parser_test
+
+import (
+	"fmt"
+
+	"github.com/tmc/mongologtools/parser"
+)
+
+func ExampleParseLogLineWithOptions() {
+	line := "Mon Feb 23 03:20:19.670 [conn1] query test.places query: { _id: ObjectId('54e792daf1845f045f4c000e'), loc: Point(1, 2) } ntoreturn:0 ntoskip:0 nscanned:0 keyUpdates:0 locks(micros) r:86 nreturned:0 reslen:20 0ms"
+	converter := parser.ValueConverterFunc(func(kind string, args ...interface{}) (interface{}, error) {
+		if kind == "Point" {
+			return fmt.Sprintf("POINT(%v %v)", args[0], args[1]), nil
+		}
+		return parser.NativeConverter.Convert(kind, args...)
+	})
+	doc, err := parser.ParseLogLineWithOptions(line, parser.ParseOptions{Converter: converter})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	query := doc["query"].(map[string]interface{})
+	fmt.Printf("%T %v\n", query["_id"], query["loc"])
+	// output:
+	// [12]uint8 POINT(1 2)
+}